@@ -0,0 +1,272 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+// ARC represents adaptive replacement cache that balances between recently and frequently used entries, it keeps
+// entries seen once and entries seen at least twice in separate lists and tracks keys recently evicted from each of
+// them (ghost entries, without values) to adapt target size of lists to access pattern
+type ARC[K comparable, V any] struct {
+	mu            sync.Mutex
+	capacity      int
+	target        int
+	items         maps.Map[K, *entry[K, V]]
+	recent        *list[K, V]
+	frequent      *list[K, V]
+	recentGhost   *list[K, V]
+	frequentGhost *list[K, V]
+	tick          uint64
+	onEvict       EvictCallback[K, V]
+	stats         Stats
+}
+
+// NewARC creates new ARC cache with specified capacity and optional eviction callback
+func NewARC[K comparable, V any](capacity int, onEvict EvictCallback[K, V]) (*ARC[K, V], error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidCapacity
+	}
+
+	return &ARC[K, V]{
+		capacity:      capacity,
+		items:         make(maps.Map[K, *entry[K, V]], 2*capacity),
+		recent:        newList[K, V](),
+		frequent:      newList[K, V](),
+		recentGhost:   newList[K, V](),
+		frequentGhost: newList[K, V](),
+		onEvict:       onEvict,
+	}, nil
+}
+
+// Get returns value stored by key and true if key is present, marks entry as frequently used
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found || !c.cached(e) {
+		c.stats.Misses++
+		var empty V
+		return empty, false
+	}
+
+	c.stats.Hits++
+	c.promote(e)
+	return e.value, true
+}
+
+// Put stores value by key, returns true if other entry was evicted to free space
+func (c *ARC[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	evicted, ok := c.put(key, value)
+	c.mu.Unlock()
+
+	notifyEvicted(c.onEvict, evicted, ok)
+	return ok
+}
+
+// put stores value by key and returns evicted entry if any
+func (c *ARC[K, V]) put(key K, value V) (maps.Entry[K, V], bool) {
+	e, found := c.items[key]
+	if found {
+		switch e.list {
+		case c.recentGhost:
+			c.target = minInt(c.capacity, c.target+maxInt(c.frequentGhost.len/c.recentGhost.len, 1))
+			evicted, ok := c.replace(false)
+			c.recentGhost.remove(e)
+			e.value = value
+			c.promote(e)
+			return evicted, ok
+		case c.frequentGhost:
+			c.target = maxInt(0, c.target-maxInt(c.recentGhost.len/c.frequentGhost.len, 1))
+			evicted, ok := c.replace(true)
+			c.frequentGhost.remove(e)
+			e.value = value
+			c.promote(e)
+			return evicted, ok
+		default:
+			e.value = value
+			c.promote(e)
+			return maps.Entry[K, V]{}, false
+		}
+	}
+
+	var evicted maps.Entry[K, V]
+	ok := false
+	if c.recent.len+c.recentGhost.len >= c.capacity {
+		if c.recent.len < c.capacity {
+			c.dropGhost(c.recentGhost)
+			evicted, ok = c.replace(false)
+		} else {
+			oldest := c.recent.back()
+			c.recent.remove(oldest)
+			delete(c.items, oldest.key)
+			c.stats.Evictions++
+			evicted, ok = maps.NewEntry(oldest.key, oldest.value), true
+		}
+	} else if total := c.recent.len + c.frequent.len + c.recentGhost.len + c.frequentGhost.len; total >= c.capacity {
+		if total >= 2*c.capacity {
+			c.dropGhost(c.frequentGhost)
+		}
+		evicted, ok = c.replace(false)
+	}
+
+	c.tick++
+	e = &entry[K, V]{
+		key:   key,
+		value: value,
+		tick:  c.tick,
+	}
+	c.items[key] = e
+	c.recent.pushFront(e)
+
+	return evicted, ok
+}
+
+// Peek returns value stored by key and true if key is present without marking entry as used or updating stats
+func (c *ARC[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found || !c.cached(e) {
+		var empty V
+		return empty, false
+	}
+	return e.value, true
+}
+
+// Remove removes key from cache and returns true if key was present, eviction callback is not called
+func (c *ARC[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found {
+		return false
+	}
+
+	cached := c.cached(e)
+	e.list.remove(e)
+	delete(c.items, key)
+	return cached
+}
+
+// Len returns number of entries in cache, ghost entries are not counted
+func (c *ARC[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.recent.len + c.frequent.len
+}
+
+// Purge removes all entries from cache, eviction callback is not called and stats are kept
+func (c *ARC[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.target = 0
+	c.items = make(maps.Map[K, *entry[K, V]], 2*c.capacity)
+	c.recent = newList[K, V]()
+	c.frequent = newList[K, V]()
+	c.recentGhost = newList[K, V]()
+	c.frequentGhost = newList[K, V]()
+}
+
+// Stats returns usage statistics of cache
+func (c *ARC[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// Entries returns entries of cache from the most to the least recently used
+func (c *ARC[K, V]) Entries() []maps.Entry[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]*entry[K, V], 0, c.recent.len+c.frequent.len)
+	for _, l := range []*list[K, V]{c.recent, c.frequent} {
+		for e := l.front(); e != nil; e = l.next(e) {
+			entries = append(entries, e)
+		}
+	}
+	return entriesByTick(entries)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// cached returns true if entry has value (is not a ghost)
+func (c *ARC[K, V]) cached(e *entry[K, V]) bool {
+	return e.list == c.recent || e.list == c.frequent
+}
+
+// promote marks entry as the most recently used in list of frequently used entries
+func (c *ARC[K, V]) promote(e *entry[K, V]) {
+	if e.list == c.frequent {
+		c.frequent.moveToFront(e)
+	} else {
+		if e.list != nil {
+			e.list.remove(e)
+		}
+		c.frequent.pushFront(e)
+	}
+
+	c.tick++
+	e.tick = c.tick
+}
+
+// replace evicts the least recently used entry from list of recently or frequently used entries depending on their
+// target size turning it into ghost entry, does nothing if cache is not full
+func (c *ARC[K, V]) replace(frequentGhostHit bool) (maps.Entry[K, V], bool) {
+	if c.recent.len+c.frequent.len < c.capacity {
+		return maps.Entry[K, V]{}, false
+	}
+
+	from, to := c.frequent, c.frequentGhost
+	if c.recent.len > 0 && (c.recent.len > c.target || (frequentGhostHit && c.recent.len == c.target)) ||
+		c.frequent.len == 0 {
+		from, to = c.recent, c.recentGhost
+	}
+
+	e := from.back()
+	from.remove(e)
+	evicted := maps.NewEntry(e.key, e.value)
+
+	var empty V
+	e.value = empty
+	to.pushFront(e)
+
+	c.stats.Evictions++
+	return evicted, true
+}
+
+// dropGhost removes the least recently used ghost entry from list
+func (c *ARC[K, V]) dropGhost(l *list[K, V]) {
+	e := l.back()
+	if e == nil {
+		return
+	}
+
+	l.remove(e)
+	delete(c.items, e.key)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
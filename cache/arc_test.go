@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+func TestARC_ScanResistance(t *testing.T) {
+	c, err := NewARC[string, int](4, nil)
+	require.NoError(t, err)
+
+	c.Put("hot1", 1)
+	c.Put("hot2", 2)
+	c.Get("hot1")
+	c.Get("hot2")
+
+	// One-time scan must not evict frequently used entries
+	for i := 0; i < 20; i++ {
+		c.Put("scan"+strconv.Itoa(i), i)
+	}
+
+	_, found := c.Peek("hot1")
+	assert.True(t, found)
+	_, found = c.Peek("hot2")
+	assert.True(t, found)
+	assert.Equal(t, 4, c.Len())
+}
+
+func TestARC_GhostHit(t *testing.T) {
+	var evicted []string
+	c, err := NewARC(2, func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	require.NoError(t, err)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	assert.True(t, c.Put("c", 3))
+	c.Put("b", 4)
+
+	// "c" becomes a ghost, it's not present, but putting it again moves it to frequently used entries
+	assert.True(t, c.Put("d", 5))
+	_, found := c.Peek("c")
+	assert.False(t, found)
+	_, found = c.Get("c")
+	assert.False(t, found)
+
+	assert.True(t, c.Put("c", 6))
+	assert.Equal(t, []string{"a", "c", "b"}, evicted)
+	assert.Equal(t, 2, c.Len())
+
+	value, found := c.Get("c")
+	assert.True(t, found)
+	assert.Equal(t, 6, value)
+
+	assert.Equal(t, []maps.Entry[string, int]{
+		{Key: "c", Value: 6},
+		{Key: "d", Value: 5},
+	}, c.Entries())
+
+	assert.True(t, c.Put("e", 7))
+	assert.False(t, c.Remove("b"), "ghost entries are not reported as removed")
+}
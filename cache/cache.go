@@ -0,0 +1,76 @@
+/*
+Package cache provides generic size-bounded caches with different eviction policies.
+*/
+package cache
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+// ErrInvalidCapacity returned when cache is created with non-positive capacity
+var ErrInvalidCapacity = errors.New("cache: capacity must be positive")
+
+// Cache represents generic size-bounded cache, all implementations are safe for concurrent use
+type Cache[K comparable, V any] interface {
+	// Get returns value stored by key and true if key is present, marks entry as used
+	Get(key K) (V, bool)
+	// Put stores value by key marking entry as used, returns true if other entry was evicted to free space
+	Put(key K, value V) bool
+	// Peek returns value stored by key and true if key is present without marking entry as used or updating stats
+	Peek(key K) (V, bool)
+	// Remove removes key from cache and returns true if key was present, eviction callback is not called
+	Remove(key K) bool
+	// Len returns number of entries in cache
+	Len() int
+	// Purge removes all entries from cache, eviction callback is not called and stats are kept
+	Purge()
+	// Stats returns usage statistics of cache
+	Stats() Stats
+	// Entries returns entries of cache from the most to the least recently used
+	Entries() []maps.Entry[K, V]
+}
+
+// EvictCallback defines function that is called with entry evicted from cache to free space, it's called after cache
+// is unlocked, so it can safely access cache
+type EvictCallback[K comparable, V any] func(key K, value V)
+
+// Stats represents usage statistics of cache
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRatio returns ratio of hits to all lookups, zero if there were no lookups
+func (s Stats) HitRatio() float64 {
+	lookups := s.Hits + s.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(lookups)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// notifyEvicted calls eviction callback with evicted entry if any
+func notifyEvicted[K comparable, V any](onEvict EvictCallback[K, V], evicted maps.Entry[K, V], ok bool) {
+	if ok && onEvict != nil {
+		onEvict(evicted.Key, evicted.Value)
+	}
+}
+
+// entriesByTick returns entries sorted from the most to the least recently used by their ticks
+func entriesByTick[K comparable, V any](entries []*entry[K, V]) []maps.Entry[K, V] {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].tick > entries[j].tick
+	})
+
+	result := make([]maps.Entry[K, V], len(entries))
+	for i, e := range entries {
+		result[i] = maps.NewEntry(e.key, e.value)
+	}
+	return result
+}
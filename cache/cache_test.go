@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var cacheConstructors = []struct {
+	name string
+	new  func(capacity int, onEvict EvictCallback[string, int]) (Cache[string, int], error)
+}{
+	{
+		name: "lru",
+		new: func(capacity int, onEvict EvictCallback[string, int]) (Cache[string, int], error) {
+			return NewLRU(capacity, onEvict)
+		},
+	},
+	{
+		name: "lfu",
+		new: func(capacity int, onEvict EvictCallback[string, int]) (Cache[string, int], error) {
+			return NewLFU(capacity, onEvict)
+		},
+	},
+	{
+		name: "arc",
+		new: func(capacity int, onEvict EvictCallback[string, int]) (Cache[string, int], error) {
+			return NewARC(capacity, onEvict)
+		},
+	},
+}
+
+func TestStats_HitRatio(t *testing.T) {
+	assert.Equal(t, 0.0, Stats{}.HitRatio())
+	assert.Equal(t, 0.25, Stats{Hits: 1, Misses: 3}.HitRatio())
+	assert.Equal(t, 1.0, Stats{Hits: 2}.HitRatio())
+}
+
+func TestCache_InvalidCapacity(t *testing.T) {
+	for _, tt := range cacheConstructors {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.new(0, nil)
+			assert.ErrorIs(t, err, ErrInvalidCapacity)
+
+			_, err = tt.new(-1, nil)
+			assert.ErrorIs(t, err, ErrInvalidCapacity)
+		})
+	}
+}
+
+func TestCache_Common(t *testing.T) {
+	for _, tt := range cacheConstructors {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []string
+			c, err := tt.new(2, func(key string, _ int) {
+				evicted = append(evicted, key)
+			})
+			require.NoError(t, err)
+
+			_, found := c.Get("a")
+			assert.False(t, found)
+
+			assert.False(t, c.Put("a", 1))
+			assert.False(t, c.Put("b", 2))
+			assert.False(t, c.Put("a", 3))
+			assert.Equal(t, 2, c.Len())
+
+			value, found := c.Get("a")
+			assert.True(t, found)
+			assert.Equal(t, 3, value)
+
+			value, found = c.Peek("b")
+			assert.True(t, found)
+			assert.Equal(t, 2, value)
+
+			assert.True(t, c.Put("c", 4))
+			assert.Equal(t, []string{"b"}, evicted)
+			assert.Equal(t, 2, c.Len())
+
+			_, found = c.Peek("b")
+			assert.False(t, found)
+
+			assert.True(t, c.Remove("a"))
+			assert.False(t, c.Remove("a"))
+			assert.Equal(t, 1, c.Len())
+
+			assert.Equal(t, Stats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+
+			c.Purge()
+			assert.Equal(t, 0, c.Len())
+			assert.Empty(t, c.Entries())
+			assert.Equal(t, Stats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+			assert.Equal(t, []string{"b"}, evicted)
+		})
+	}
+}
+
+func TestCache_EvictCallbackUnlocked(t *testing.T) {
+	for _, tt := range cacheConstructors {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Cache[string, int]
+			var err error
+			c, err = tt.new(1, func(key string, value int) {
+				assert.Equal(t, 1, c.Len())
+			})
+			require.NoError(t, err)
+
+			c.Put("a", 1)
+			assert.True(t, c.Put("b", 2))
+		})
+	}
+}
+
+func TestCache_Concurrent(t *testing.T) {
+	for _, tt := range cacheConstructors {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := tt.new(16, nil)
+			require.NoError(t, err)
+
+			wg := sync.WaitGroup{}
+			for worker := 0; worker < 8; worker++ {
+				wg.Add(1)
+				go func(worker int) {
+					defer wg.Done()
+					for i := 0; i < 500; i++ {
+						key := strconv.Itoa((i * (worker + 1)) % 40)
+						c.Put(key, i)
+						c.Get(key)
+						c.Peek(key)
+						if i%7 == 0 {
+							c.Remove(key)
+						}
+						_ = c.Entries()
+					}
+				}(worker)
+			}
+			wg.Wait()
+
+			assert.LessOrEqual(t, c.Len(), 16)
+			assert.Len(t, c.Entries(), c.Len())
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+// LFU represents cache that evicts the least frequently used entry, ties are broken by evicting the least recently
+// used one
+type LFU[K comparable, V any] struct {
+	mu           sync.Mutex
+	capacity     int
+	items        maps.Map[K, *entry[K, V]]
+	frequencies  maps.Map[int, *list[K, V]]
+	minFrequency int
+	tick         uint64
+	onEvict      EvictCallback[K, V]
+	stats        Stats
+}
+
+// NewLFU creates new LFU cache with specified capacity and optional eviction callback
+func NewLFU[K comparable, V any](capacity int, onEvict EvictCallback[K, V]) (*LFU[K, V], error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidCapacity
+	}
+
+	return &LFU[K, V]{
+		capacity:    capacity,
+		items:       make(maps.Map[K, *entry[K, V]], capacity),
+		frequencies: make(maps.Map[int, *list[K, V]]),
+		onEvict:     onEvict,
+	}, nil
+}
+
+// Get returns value stored by key and true if key is present, increases use frequency of entry
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found {
+		c.stats.Misses++
+		var empty V
+		return empty, false
+	}
+
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Put stores value by key increasing use frequency of entry, returns true if the least frequently used entry was
+// evicted
+func (c *LFU[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	evicted, ok := c.put(key, value)
+	c.mu.Unlock()
+
+	notifyEvicted(c.onEvict, evicted, ok)
+	return ok
+}
+
+// put stores value by key and returns evicted entry if any
+func (c *LFU[K, V]) put(key K, value V) (maps.Entry[K, V], bool) {
+	if e, found := c.items[key]; found {
+		e.value = value
+		c.touch(e)
+		return maps.Entry[K, V]{}, false
+	}
+
+	var evicted maps.Entry[K, V]
+	ok := false
+	if len(c.items) >= c.capacity {
+		evicted, ok = c.evict()
+	}
+
+	c.tick++
+	e := &entry[K, V]{
+		key:       key,
+		value:     value,
+		frequency: 1,
+		tick:      c.tick,
+	}
+	c.items[key] = e
+	c.frequencyList(1).pushFront(e)
+	c.minFrequency = 1
+
+	return evicted, ok
+}
+
+// Peek returns value stored by key and true if key is present without changing use frequency of entry or updating
+// stats
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found {
+		var empty V
+		return empty, false
+	}
+	return e.value, true
+}
+
+// Remove removes key from cache and returns true if key was present, eviction callback is not called
+func (c *LFU[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found {
+		return false
+	}
+
+	c.unlink(e)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns number of entries in cache
+func (c *LFU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.items)
+}
+
+// Purge removes all entries from cache, eviction callback is not called and stats are kept
+func (c *LFU[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(maps.Map[K, *entry[K, V]], c.capacity)
+	c.frequencies = make(maps.Map[int, *list[K, V]])
+	c.minFrequency = 0
+}
+
+// Stats returns usage statistics of cache
+func (c *LFU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// Entries returns entries of cache from the most to the least recently used
+func (c *LFU[K, V]) Entries() []maps.Entry[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]*entry[K, V], 0, len(c.items))
+	for _, e := range c.items {
+		entries = append(entries, e)
+	}
+	return entriesByTick(entries)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// touch increases use frequency of entry and marks it as the most recently used
+func (c *LFU[K, V]) touch(e *entry[K, V]) {
+	c.unlink(e)
+	e.frequency++
+	c.tick++
+	e.tick = c.tick
+	c.frequencyList(e.frequency).pushFront(e)
+}
+
+// unlink removes entry from list of its frequency, list is removed if it becomes empty
+func (c *LFU[K, V]) unlink(e *entry[K, V]) {
+	l := e.list
+	l.remove(e)
+	if l.len > 0 {
+		return
+	}
+
+	delete(c.frequencies, e.frequency)
+	if c.minFrequency == e.frequency {
+		c.minFrequency++
+	}
+}
+
+// frequencyList returns list of entries with specified frequency, creating it if needed
+func (c *LFU[K, V]) frequencyList(frequency int) *list[K, V] {
+	l, found := c.frequencies[frequency]
+	if !found {
+		l = newList[K, V]()
+		c.frequencies[frequency] = l
+	}
+	return l
+}
+
+// evict removes the least recently used entry of the least frequency and returns it
+func (c *LFU[K, V]) evict() (maps.Entry[K, V], bool) {
+	l, found := c.frequencies[c.minFrequency]
+	if !found {
+		// Minimal frequency may be outdated after removals, so it's recalculated
+		c.minFrequency = 0
+		for frequency := range c.frequencies {
+			if c.minFrequency == 0 || frequency < c.minFrequency {
+				c.minFrequency = frequency
+			}
+		}
+
+		if l, found = c.frequencies[c.minFrequency]; !found {
+			return maps.Entry[K, V]{}, false
+		}
+	}
+
+	e := l.back()
+	c.unlink(e)
+	delete(c.items, e.key)
+	c.stats.Evictions++
+	return maps.NewEntry(e.key, e.value), true
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+func TestLFU_Eviction(t *testing.T) {
+	var evicted []string
+	c, err := NewLFU(3, func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	require.NoError(t, err)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+
+	// "c" is the least frequently used
+	assert.True(t, c.Put("d", 4))
+
+	// "d" is the least frequently used
+	assert.True(t, c.Put("e", 5))
+
+	// "b" and "e" are the least frequently used, "b" is less recently used
+	c.Get("e")
+	assert.True(t, c.Put("f", 6))
+
+	assert.Equal(t, []string{"c", "d", "b"}, evicted)
+	assert.Equal(t, []maps.Entry[string, int]{
+		{Key: "f", Value: 6},
+		{Key: "e", Value: 5},
+		{Key: "a", Value: 1},
+	}, c.Entries())
+}
+
+func TestLFU_RemoveMinFrequency(t *testing.T) {
+	c, err := NewLFU[string, int](2, nil)
+	require.NoError(t, err)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("b")
+	c.Get("b")
+
+	assert.True(t, c.Remove("a"))
+	c.Put("c", 3)
+	c.Get("c")
+	c.Get("c")
+	c.Get("c")
+
+	// Frequency of "b" is 3 and of "c" is 4
+	assert.True(t, c.Put("d", 4))
+	_, found := c.Peek("b")
+	assert.False(t, found)
+	_, found = c.Peek("c")
+	assert.True(t, found)
+}
@@ -0,0 +1,81 @@
+package cache
+
+// entry represents cached entry stored in list
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	frequency int
+	tick      uint64
+
+	list *list[K, V]
+	prev *entry[K, V]
+	next *entry[K, V]
+}
+
+// list represents doubly linked circular list of entries with sentinel root, front is the most recent entry
+type list[K comparable, V any] struct {
+	root entry[K, V]
+	len  int
+}
+
+// newList creates new empty list
+func newList[K comparable, V any]() *list[K, V] {
+	l := &list[K, V]{}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	return l
+}
+
+// front returns the first entry of list or nil if list is empty
+func (l *list[K, V]) front() *entry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// back returns the last entry of list or nil if list is empty
+func (l *list[K, V]) back() *entry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// next returns entry after specified one or nil if it is the last one
+func (l *list[K, V]) next(e *entry[K, V]) *entry[K, V] {
+	if e.next == &l.root {
+		return nil
+	}
+	return e.next
+}
+
+// pushFront inserts entry at the front of list
+func (l *list[K, V]) pushFront(e *entry[K, V]) {
+	e.list = l
+	e.prev = &l.root
+	e.next = l.root.next
+	l.root.next.prev = e
+	l.root.next = e
+	l.len++
+}
+
+// remove removes entry from list
+func (l *list[K, V]) remove(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.list = nil
+	e.prev = nil
+	e.next = nil
+	l.len--
+}
+
+// moveToFront moves entry of list to its front
+func (l *list[K, V]) moveToFront(e *entry[K, V]) {
+	if l.root.next == e {
+		return
+	}
+
+	l.remove(e)
+	l.pushFront(e)
+}
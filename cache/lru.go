@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+// LRU represents cache that evicts the least recently used entry
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	items    maps.Map[K, *entry[K, V]]
+	order    *list[K, V]
+	onEvict  EvictCallback[K, V]
+	stats    Stats
+}
+
+// NewLRU creates new LRU cache with specified capacity and optional eviction callback
+func NewLRU[K comparable, V any](capacity int, onEvict EvictCallback[K, V]) (*LRU[K, V], error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidCapacity
+	}
+
+	return &LRU[K, V]{
+		capacity: capacity,
+		items:    make(maps.Map[K, *entry[K, V]], capacity),
+		order:    newList[K, V](),
+		onEvict:  onEvict,
+	}, nil
+}
+
+// Get returns value stored by key and true if key is present, marks entry as used
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found {
+		c.stats.Misses++
+		var empty V
+		return empty, false
+	}
+
+	c.stats.Hits++
+	c.order.moveToFront(e)
+	return e.value, true
+}
+
+// Put stores value by key marking entry as used, returns true if the least recently used entry was evicted
+func (c *LRU[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	evicted, ok := c.put(key, value)
+	c.mu.Unlock()
+
+	notifyEvicted(c.onEvict, evicted, ok)
+	return ok
+}
+
+// put stores value by key and returns evicted entry if any
+func (c *LRU[K, V]) put(key K, value V) (maps.Entry[K, V], bool) {
+	if e, found := c.items[key]; found {
+		e.value = value
+		c.order.moveToFront(e)
+		return maps.Entry[K, V]{}, false
+	}
+
+	e := &entry[K, V]{
+		key:   key,
+		value: value,
+	}
+	c.items[key] = e
+	c.order.pushFront(e)
+
+	if c.order.len <= c.capacity {
+		return maps.Entry[K, V]{}, false
+	}
+
+	oldest := c.order.back()
+	c.order.remove(oldest)
+	delete(c.items, oldest.key)
+	c.stats.Evictions++
+	return maps.NewEntry(oldest.key, oldest.value), true
+}
+
+// Peek returns value stored by key and true if key is present without marking entry as used or updating stats
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found {
+		var empty V
+		return empty, false
+	}
+	return e.value, true
+}
+
+// Remove removes key from cache and returns true if key was present, eviction callback is not called
+func (c *LRU[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.items[key]
+	if !found {
+		return false
+	}
+
+	c.order.remove(e)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns number of entries in cache
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.items)
+}
+
+// Purge removes all entries from cache, eviction callback is not called and stats are kept
+func (c *LRU[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(maps.Map[K, *entry[K, V]], c.capacity)
+	c.order = newList[K, V]()
+}
+
+// Stats returns usage statistics of cache
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// Entries returns entries of cache from the most to the least recently used
+func (c *LRU[K, V]) Entries() []maps.Entry[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]maps.Entry[K, V], 0, c.order.len)
+	for e := c.order.front(); e != nil; e = c.order.next(e) {
+		entries = append(entries, maps.NewEntry(e.key, e.value))
+	}
+	return entries
+}
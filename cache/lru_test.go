@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+func TestLRU_Eviction(t *testing.T) {
+	c, err := NewLRU[string, int](3, nil)
+	require.NoError(t, err)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Peek("b")
+
+	assert.Equal(t, []maps.Entry[string, int]{
+		{Key: "a", Value: 1},
+		{Key: "c", Value: 3},
+		{Key: "b", Value: 2},
+	}, c.Entries())
+
+	assert.True(t, c.Put("d", 4))
+	_, found := c.Peek("b")
+	assert.False(t, found)
+
+	c.Put("c", 5)
+	assert.True(t, c.Put("e", 6))
+	_, found = c.Peek("a")
+	assert.False(t, found)
+
+	assert.Equal(t, []maps.Entry[string, int]{
+		{Key: "e", Value: 6},
+		{Key: "c", Value: 5},
+		{Key: "d", Value: 4},
+	}, c.Entries())
+}
@@ -0,0 +1,125 @@
+package is
+
+// SwitchBuilder represents chained multi-way selection of result by value, the first matching case wins
+type SwitchBuilder[T comparable, R any] struct {
+	value   T
+	result  R
+	matched bool
+}
+
+// Switch starts multi-way selection of result by value, for example:
+//
+//	name := is.Switch[int, string](code).
+//		Case(200, "OK").
+//		Case(404, "Not Found").
+//		Default("Unknown")
+func Switch[T comparable, R any](value T) SwitchBuilder[T, R] {
+	return SwitchBuilder[T, R]{
+		value: value,
+	}
+}
+
+// Case selects result if value equals to specified one and no previous case matched
+func (s SwitchBuilder[T, R]) Case(value T, result R) SwitchBuilder[T, R] {
+	if !s.matched && s.value == value {
+		s.result = result
+		s.matched = true
+	}
+	return s
+}
+
+// CaseFunc selects result of provided function if value equals to specified one and no previous case matched,
+// function is called only if case is selected
+func (s SwitchBuilder[T, R]) CaseFunc(value T, result func() R) SwitchBuilder[T, R] {
+	if !s.matched && s.value == value {
+		s.result = result()
+		s.matched = true
+	}
+	return s
+}
+
+// Result returns selected result and true if any case matched, otherwise zero value and false
+func (s SwitchBuilder[T, R]) Result() (R, bool) {
+	return s.result, s.matched
+}
+
+// Default returns selected result if any case matched, otherwise provided result
+func (s SwitchBuilder[T, R]) Default(result R) R {
+	if s.matched {
+		return s.result
+	}
+	return result
+}
+
+// DefaultFunc returns selected result if any case matched, otherwise result of provided function, function is called
+// only if no case matched
+func (s SwitchBuilder[T, R]) DefaultFunc(result func() R) R {
+	if s.matched {
+		return s.result
+	}
+	return result()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// CaseBuilder represents chained multi-way selection of result by conditions, the first true condition wins
+type CaseBuilder[R any] struct {
+	result  R
+	matched bool
+}
+
+// Case starts multi-way selection of result by conditions with the first case, for example:
+//
+//	size := is.Case(n < 10, "small").
+//		Case(n < 100, "medium").
+//		Default("large")
+func Case[R any](condition bool, result R) CaseBuilder[R] {
+	return CaseBuilder[R]{}.Case(condition, result)
+}
+
+// CaseFunc starts multi-way selection of result by conditions with the first case, function is called only if case
+// is selected
+func CaseFunc[R any](condition bool, result func() R) CaseBuilder[R] {
+	return CaseBuilder[R]{}.CaseFunc(condition, result)
+}
+
+// Case selects result if condition is true and no previous case matched
+func (c CaseBuilder[R]) Case(condition bool, result R) CaseBuilder[R] {
+	if !c.matched && condition {
+		c.result = result
+		c.matched = true
+	}
+	return c
+}
+
+// CaseFunc selects result of provided function if condition is true and no previous case matched, function is called
+// only if case is selected
+func (c CaseBuilder[R]) CaseFunc(condition bool, result func() R) CaseBuilder[R] {
+	if !c.matched && condition {
+		c.result = result()
+		c.matched = true
+	}
+	return c
+}
+
+// Result returns selected result and true if any case matched, otherwise zero value and false
+func (c CaseBuilder[R]) Result() (R, bool) {
+	return c.result, c.matched
+}
+
+// Default returns selected result if any case matched, otherwise provided result
+func (c CaseBuilder[R]) Default(result R) R {
+	if c.matched {
+		return c.result
+	}
+	return result
+}
+
+// DefaultFunc returns selected result if any case matched, otherwise result of provided function, function is called
+// only if no case matched
+func (c CaseBuilder[R]) DefaultFunc(result func() R) R {
+	if c.matched {
+		return c.result
+	}
+	return result()
+}
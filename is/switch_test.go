@@ -0,0 +1,79 @@
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func statusText(code int) string {
+	return Switch[int, string](code).
+		Case(200, "OK").
+		Case(404, "Not Found").
+		Case(404, "Duplicate").
+		Default("Unknown")
+}
+
+func TestSwitch(t *testing.T) {
+	assert.Equal(t, "OK", statusText(200))
+	assert.Equal(t, "Not Found", statusText(404))
+	assert.Equal(t, "Unknown", statusText(500))
+
+	result, ok := Switch[string, int]("b").Case("a", 1).Result()
+	assert.False(t, ok)
+	assert.Equal(t, 0, result)
+
+	result, ok = Switch[string, int]("a").Case("a", 1).Result()
+	assert.True(t, ok)
+	assert.Equal(t, 1, result)
+}
+
+func TestSwitch_Func(t *testing.T) {
+	notCalled := func() int {
+		assert.Fail(t, "must not be called")
+		return -1
+	}
+
+	assert.Equal(t, 2, Switch[int, int](2).
+		CaseFunc(1, notCalled).
+		CaseFunc(2, func() int { return 2 }).
+		CaseFunc(2, notCalled).
+		DefaultFunc(notCalled))
+
+	assert.Equal(t, 3, Switch[int, int](3).CaseFunc(1, notCalled).DefaultFunc(func() int { return 3 }))
+}
+
+func TestCase(t *testing.T) {
+	size := func(n int) string {
+		return Case(n < 10, "small").
+			Case(n < 100, "medium").
+			Default("large")
+	}
+
+	assert.Equal(t, "small", size(1))
+	assert.Equal(t, "medium", size(10))
+	assert.Equal(t, "large", size(100))
+
+	result, ok := Case(false, 1).Case(false, 2).Result()
+	assert.False(t, ok)
+	assert.Equal(t, 0, result)
+}
+
+func TestCase_Func(t *testing.T) {
+	var p *named
+	notCalled := func() string {
+		assert.Fail(t, "must not be called")
+		return ""
+	}
+
+	assert.Equal(t, "none", CaseFunc(p != nil, func() string { return p.Name }).
+		CaseFunc(p == nil, func() string { return "none" }).
+		DefaultFunc(notCalled))
+
+	p = &named{Name: "aki"}
+	assert.Equal(t, "aki", CaseFunc(p != nil, func() string { return p.Name }).
+		CaseFunc(true, notCalled).
+		Default("default"))
+
+	assert.Equal(t, "default", CaseFunc(false, notCalled).DefaultFunc(func() string { return "default" }))
+}
@@ -17,9 +17,42 @@ func IfTrue[T any](is bool, ifTrue T) T {
 	return types.Empty[T]()
 }
 
+// IfFunc returns result of ifTrue if is true, otherwise result of ifFalse, only one of them is called
+func IfFunc[T any](is bool, ifTrue, ifFalse func() T) T {
+	if is {
+		return ifTrue()
+	}
+	return ifFalse()
+}
+
+// IfLazy returns result of ifTrue if is true, otherwise ifFalse, ifTrue is called only if is true, useful when true
+// branch can't be evaluated otherwise (for example, `is.IfLazy(p != nil, func() string { return p.Name }, "")`)
+func IfLazy[T any](is bool, ifTrue func() T, ifFalse T) T {
+	if is {
+		return ifTrue()
+	}
+	return ifFalse
+}
+
+// IfTrueFunc returns result of ifTrue if is true, otherwise zero value, ifTrue is called only if is true
+func IfTrueFunc[T any](is bool, ifTrue func() T) T {
+	if is {
+		return ifTrue()
+	}
+	return types.Empty[T]()
+}
+
 func Or[T comparable](first, second T) T {
 	if first != types.Empty[T]() {
 		return first
 	}
 	return second
 }
+
+// OrFunc returns first if it's not zero value, otherwise result of second, second is called only if needed
+func OrFunc[T comparable](first T, second func() T) T {
+	if first != types.Empty[T]() {
+		return first
+	}
+	return second()
+}
@@ -0,0 +1,53 @@
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type named struct {
+	Name string
+}
+
+func TestIfFunc(t *testing.T) {
+	calls := 0
+	value := func(v int) func() int {
+		return func() int {
+			calls++
+			return v
+		}
+	}
+
+	assert.Equal(t, 1, IfFunc(true, value(1), value(2)))
+	assert.Equal(t, 2, IfFunc(false, value(1), value(2)))
+	assert.Equal(t, 2, calls)
+}
+
+func TestIfLazy(t *testing.T) {
+	var p *named
+	name := func() string { return p.Name }
+
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "", IfLazy(p != nil, name, ""))
+	})
+
+	p = &named{Name: "aki"}
+	assert.Equal(t, "aki", IfLazy(p != nil, name, ""))
+}
+
+func TestIfTrueFunc(t *testing.T) {
+	assert.Equal(t, 1, IfTrueFunc(true, func() int { return 1 }))
+	assert.Equal(t, 0, IfTrueFunc(false, func() int {
+		assert.Fail(t, "must not be called")
+		return 1
+	}))
+}
+
+func TestOrFunc(t *testing.T) {
+	assert.Equal(t, "a", OrFunc("a", func() string {
+		assert.Fail(t, "must not be called")
+		return "b"
+	}))
+	assert.Equal(t, "b", OrFunc("", func() string { return "b" }))
+}
@@ -0,0 +1,116 @@
+package is
+
+import (
+	"reflect" //nolint:depguard // Required to check zero & nil values of non-comparable types
+
+	"github.com/mymmrac/aki/types"
+)
+
+// Coalesce returns the first of values that is not zero value, zero value if all of them are zero
+func Coalesce[T comparable](values ...T) T {
+	empty := types.Empty[T]()
+	for _, value := range values {
+		if value != empty {
+			return value
+		}
+	}
+	return empty
+}
+
+// CoalesceFunc returns the first result of suppliers that is not zero value, zero value if all of them are zero,
+// suppliers are called in order only until non-zero result is found
+func CoalesceFunc[T comparable](suppliers ...func() T) T {
+	empty := types.Empty[T]()
+	for _, supplier := range suppliers {
+		if value := supplier(); value != empty {
+			return value
+		}
+	}
+	return empty
+}
+
+// Zero returns true if value is zero value of its type, works for any type including non-comparable ones (slices,
+// maps, funcs), note that empty but non-nil slice or map is not zero value
+//
+// Note: Values are compared using == same as in Coalesce (so -0.0 is zero value), reflection is used only for types
+// that can't be safely compared
+func Zero[T any](value T) bool {
+	boxed := any(value)
+	if boxed == nil {
+		return true
+	}
+
+	if safelyComparable(reflect.TypeOf(boxed)) {
+		return boxed == any(types.Empty[T]())
+	}
+	return zeroValue(reflect.ValueOf(&value).Elem())
+}
+
+// NotZero returns true if value is not zero value of its type, see Zero
+func NotZero[T any](value T) bool {
+	return !Zero(value)
+}
+
+// safelyComparable returns true if values of type can be compared using == without panic, interfaces are not safe
+// since they may hold non-comparable values
+func safelyComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return safelyComparable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !safelyComparable(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	default:
+		return t.Comparable()
+	}
+}
+
+// zeroValue returns true if value is zero value of its type, floats and complex numbers are compared using == to be
+// consistent with comparable types
+func zeroValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Complex64, reflect.Complex128:
+		return v.Complex() == 0
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !zeroValue(v.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Name != "_" && !zeroValue(v.Field(i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return v.IsZero()
+	}
+}
+
+// Nil returns true if value is nil or interface holding nil value of nillable type (typed nil), for example,
+// `var p *T; var v any = p` is nil
+func Nil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice,
+		reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,121 @@
+package is
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesce(t *testing.T) {
+	assert.Equal(t, "", Coalesce[string]())
+	assert.Equal(t, "", Coalesce("", ""))
+	assert.Equal(t, "b", Coalesce("", "b", "c"))
+	assert.Equal(t, 1, Coalesce(1, 2))
+
+	var p *named
+	q := &named{}
+	assert.Equal(t, q, Coalesce(p, q))
+}
+
+func TestCoalesceFunc(t *testing.T) {
+	calls := 0
+	value := func(v string) func() string {
+		return func() string {
+			calls++
+			return v
+		}
+	}
+
+	assert.Equal(t, "", CoalesceFunc[string]())
+	assert.Equal(t, "b", CoalesceFunc(value(""), value("b"), value("c")))
+	assert.Equal(t, 2, calls)
+}
+
+func TestZero(t *testing.T) {
+	var nilErr error
+	var nilPtr *named
+	negativeZero := math.Copysign(0, -1)
+
+	type withSlice struct {
+		Value  float64
+		Values []int
+	}
+	type withInterface struct {
+		Value float64
+		Any   any
+	}
+
+	tests := []struct {
+		name string
+		zero bool
+		got  bool
+	}{
+		{name: "int_zero", zero: true, got: Zero(0)},
+		{name: "int", zero: false, got: Zero(1)},
+		{name: "string_zero", zero: true, got: Zero("")},
+		{name: "struct_zero", zero: true, got: Zero(named{})},
+		{name: "struct", zero: false, got: Zero(named{Name: "a"})},
+		{name: "slice_nil", zero: true, got: Zero([]int(nil))},
+		{name: "slice_empty", zero: false, got: Zero([]int{})},
+		{name: "map_nil", zero: true, got: Zero(map[string]int(nil))},
+		{name: "map_empty", zero: false, got: Zero(map[string]int{})},
+		{name: "func_nil", zero: true, got: Zero[func()](nil)},
+		{name: "func", zero: false, got: Zero(func() {})},
+		{name: "error_nil", zero: true, got: Zero(nilErr)},
+		{name: "error", zero: false, got: Zero(errors.New("a"))},
+		{name: "pointer_nil", zero: true, got: Zero(nilPtr)},
+		{name: "any_typed_nil", zero: false, got: Zero[any](nilPtr)},
+		{name: "any_zero_value", zero: false, got: Zero[any](0)},
+		{name: "any_slice", zero: false, got: Zero[any]([]int(nil))},
+		{name: "float_negative_zero", zero: true, got: Zero(negativeZero)},
+		{name: "float_nan", zero: false, got: Zero(math.NaN())},
+		{name: "struct_slice_zero", zero: true, got: Zero(withSlice{Value: negativeZero})},
+		{name: "struct_slice", zero: false, got: Zero(withSlice{Values: []int{}})},
+		{name: "struct_interface_zero", zero: true, got: Zero(withInterface{Value: negativeZero})},
+		{name: "struct_interface", zero: false, got: Zero(withInterface{Any: []int{}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.zero, tt.got)
+		})
+	}
+
+	assert.Equal(t, 1.0, Coalesce(negativeZero, 1))
+	assert.True(t, NotZero([]int{}))
+	assert.False(t, NotZero([]int(nil)))
+}
+
+func TestNil(t *testing.T) {
+	var nilPtr *named
+	var nilErr error
+	var nilSlice []int
+	var nilMap map[int]int
+	var nilFunc func()
+	var nilChan chan int
+
+	assert.True(t, Nil(nil))
+	assert.True(t, Nil(nilPtr))
+	assert.True(t, Nil(nilErr))
+	assert.True(t, Nil(nilSlice))
+	assert.True(t, Nil(nilMap))
+	assert.True(t, Nil(nilFunc))
+	assert.True(t, Nil(nilChan))
+
+	var typedNil error = (*typedError)(nil)
+	assert.True(t, Nil(typedNil))
+
+	assert.False(t, Nil(&named{}))
+	assert.False(t, Nil([]int{}))
+	assert.False(t, Nil(0))
+	assert.False(t, Nil(""))
+	assert.False(t, Nil(named{}))
+}
+
+type typedError struct{}
+
+func (e *typedError) Error() string {
+	return "typed"
+}
@@ -0,0 +1,281 @@
+package maps
+
+import "github.com/mymmrac/aki/constraints"
+
+// Reduce reduces entries of this map into one value starting from initial one using provided reducer, entries are
+// passed to reducer with no defined order, so reducer should be commutative and associative for stable result
+func (m Map[K, V]) Reduce(initial V, reducer func(accumulator V, key K, value V) V) V {
+	return Fold(m, initial, reducer)
+}
+
+// Reduce reduces entries of specified map into one value starting from initial one using provided reducer, entries
+// are passed to reducer with no defined order, so reducer should be commutative and associative for stable result
+func Reduce[K comparable, V any](m Map[K, V], initial V, reducer func(accumulator V, key K, value V) V) V {
+	return m.Reduce(initial, reducer)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Fold folds entries of specified map into one value starting from initial one using provided folder, entries are
+// passed to folder with no defined order
+func Fold[K comparable, V, R any](m Map[K, V], initial R, folder func(accumulator R, key K, value V) R) R {
+	accumulator := initial
+	for key, value := range m {
+		accumulator = folder(accumulator, key, value)
+	}
+	return accumulator
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Count returns number of entries of this map that match by key and value using provided predicate
+func (m Map[K, V]) Count(predicate Predicate[K, V]) int {
+	count := 0
+	for key, value := range m {
+		if predicate(key, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// Count returns number of entries of specified map that match by key and value using provided predicate
+func Count[K comparable, V any](m Map[K, V], predicate Predicate[K, V]) int {
+	return m.Count(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// CountByKey returns number of entries of this map that match by key using provided predicate
+func (m Map[K, V]) CountByKey(predicate PredicateByKey[K]) int {
+	count := 0
+	for key := range m {
+		if predicate(key) {
+			count++
+		}
+	}
+	return count
+}
+
+// CountByKey returns number of entries of specified map that match by key using provided predicate
+func CountByKey[K comparable, V any](m Map[K, V], predicate PredicateByKey[K]) int {
+	return m.CountByKey(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// CountByValue returns number of entries of this map that match by value using provided predicate
+func (m Map[K, V]) CountByValue(predicate PredicateByValue[V]) int {
+	count := 0
+	for _, value := range m {
+		if predicate(value) {
+			count++
+		}
+	}
+	return count
+}
+
+// CountByValue returns number of entries of specified map that match by value using provided predicate
+func CountByValue[K comparable, V any](m Map[K, V], predicate PredicateByValue[V]) int {
+	return m.CountByValue(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Any returns true if any entry of this map matches by key and value using provided predicate
+func (m Map[K, V]) Any(predicate Predicate[K, V]) bool {
+	for key, value := range m {
+		if predicate(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Any returns true if any entry of specified map matches by key and value using provided predicate
+func Any[K comparable, V any](m Map[K, V], predicate Predicate[K, V]) bool {
+	return m.Any(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// AnyByKey returns true if any entry of this map matches by key using provided predicate
+func (m Map[K, V]) AnyByKey(predicate PredicateByKey[K]) bool {
+	for key := range m {
+		if predicate(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyByKey returns true if any entry of specified map matches by key using provided predicate
+func AnyByKey[K comparable, V any](m Map[K, V], predicate PredicateByKey[K]) bool {
+	return m.AnyByKey(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// AnyByValue returns true if any entry of this map matches by value using provided predicate
+func (m Map[K, V]) AnyByValue(predicate PredicateByValue[V]) bool {
+	for _, value := range m {
+		if predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyByValue returns true if any entry of specified map matches by value using provided predicate
+func AnyByValue[K comparable, V any](m Map[K, V], predicate PredicateByValue[V]) bool {
+	return m.AnyByValue(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// All returns true if all entries of this map match by key and value using provided predicate, true for empty map
+func (m Map[K, V]) All(predicate Predicate[K, V]) bool {
+	for key, value := range m {
+		if !predicate(key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// All returns true if all entries of specified map match by key and value using provided predicate, true for empty
+// map
+func All[K comparable, V any](m Map[K, V], predicate Predicate[K, V]) bool {
+	return m.All(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// AllByKey returns true if all entries of this map match by key using provided predicate, true for empty map
+func (m Map[K, V]) AllByKey(predicate PredicateByKey[K]) bool {
+	for key := range m {
+		if !predicate(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllByKey returns true if all entries of specified map match by key using provided predicate, true for empty map
+func AllByKey[K comparable, V any](m Map[K, V], predicate PredicateByKey[K]) bool {
+	return m.AllByKey(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// AllByValue returns true if all entries of this map match by value using provided predicate, true for empty map
+func (m Map[K, V]) AllByValue(predicate PredicateByValue[V]) bool {
+	for _, value := range m {
+		if !predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllByValue returns true if all entries of specified map match by value using provided predicate, true for empty
+// map
+func AllByValue[K comparable, V any](m Map[K, V], predicate PredicateByValue[V]) bool {
+	return m.AllByValue(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// None returns true if no entries of this map match by key and value using provided predicate
+func (m Map[K, V]) None(predicate Predicate[K, V]) bool {
+	for key, value := range m {
+		if predicate(key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// None returns true if no entries of specified map match by key and value using provided predicate
+func None[K comparable, V any](m Map[K, V], predicate Predicate[K, V]) bool {
+	return m.None(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// NoneByKey returns true if no entries of this map match by key using provided predicate
+func (m Map[K, V]) NoneByKey(predicate PredicateByKey[K]) bool {
+	for key := range m {
+		if predicate(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneByKey returns true if no entries of specified map match by key using provided predicate
+func NoneByKey[K comparable, V any](m Map[K, V], predicate PredicateByKey[K]) bool {
+	return m.NoneByKey(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// NoneByValue returns true if no entries of this map match by value using provided predicate
+func (m Map[K, V]) NoneByValue(predicate PredicateByValue[V]) bool {
+	for _, value := range m {
+		if predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneByValue returns true if no entries of specified map match by value using provided predicate
+func NoneByValue[K comparable, V any](m Map[K, V], predicate PredicateByValue[V]) bool {
+	return m.NoneByValue(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// SumValues returns sum of values of specified map, zero for empty map
+func SumValues[K comparable, V constraints.Number](m Map[K, V]) V {
+	var sum V
+	for _, value := range m {
+		sum += value
+	}
+	return sum
+}
+
+// MinValue returns entry with the smallest value of specified map and true if map is not empty, if multiple entries
+// have the smallest value, arbitrary one of them is returned
+func MinValue[K comparable, V constraints.Ordered](m Map[K, V]) (Entry[K, V], bool) {
+	return extremeValue(m, func(value, current V) bool {
+		return value < current
+	})
+}
+
+// MaxValue returns entry with the largest value of specified map and true if map is not empty, if multiple entries
+// have the largest value, arbitrary one of them is returned
+func MaxValue[K comparable, V constraints.Ordered](m Map[K, V]) (Entry[K, V], bool) {
+	return extremeValue(m, func(value, current V) bool {
+		return value > current
+	})
+}
+
+// extremeValue returns entry which value is better than values of all other entries and true if map is not empty
+func extremeValue[K comparable, V constraints.Ordered](
+	m Map[K, V], better func(value, current V) bool,
+) (Entry[K, V], bool) {
+	var extreme Entry[K, V]
+	found := false
+	for key, value := range m {
+		if !found || better(value, extreme.Value) {
+			extreme = Entry[K, V]{
+				Key:   key,
+				Value: value,
+			}
+			found = true
+		}
+	}
+	return extreme, found
+}
@@ -0,0 +1,161 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestM_Reduce(t *testing.T) {
+	sum := func(accumulator int, _ string, value int) int { return accumulator + value }
+
+	assert.Equal(t, 10, Map[string, int](nil).Reduce(10, sum))
+	assert.Equal(t, 1, Map[string, int]{"a": 1}.Reduce(0, sum))
+	assert.Equal(t, 6, Reduce(Map[string, int]{"a": 1, "b": 2, "c": 3}, 0, sum))
+
+	keys := func(accumulator int, key string, _ int) int { return accumulator + len(key) }
+	assert.Equal(t, 6, Map[string, int]{"a": 1, "bb": 2, "ccc": 3}.Reduce(0, keys))
+}
+
+func TestFold(t *testing.T) {
+	length := func(accumulator int, key string, _ int) int { return accumulator + len(key) }
+
+	assert.Equal(t, 10, Fold(Map[string, int](nil), 10, length))
+	assert.Equal(t, 6, Fold(Map[string, int]{"a": 1, "bb": 2, "ccc": 3}, 0, length))
+}
+
+var aggregateTestCases = []struct {
+	name           string
+	m              Map[int, float64]
+	predicate      Predicate[int, float64]
+	keyPredicate   PredicateByKey[int]
+	valuePredicate PredicateByValue[float64]
+	count          int
+	any            bool
+	all            bool
+	none           bool
+}{
+	{
+		name:           "nil",
+		m:              nil,
+		predicate:      func(_ int, _ float64) bool { return true },
+		keyPredicate:   func(_ int) bool { return true },
+		valuePredicate: func(_ float64) bool { return true },
+		count:          0,
+		any:            false,
+		all:            true,
+		none:           true,
+	},
+	{
+		name:           "none_match",
+		m:              Map[int, float64]{1: 2, 3: 4},
+		predicate:      func(key int, value float64) bool { return key == 2 && value == 2 },
+		keyPredicate:   func(key int) bool { return key > 3 },
+		valuePredicate: func(value float64) bool { return value < 2 },
+		count:          0,
+		any:            false,
+		all:            false,
+		none:           true,
+	},
+	{
+		name:           "some_match",
+		m:              Map[int, float64]{1: 2, 3: 4, 5: 6},
+		predicate:      func(key int, value float64) bool { return key == 1 || value == 4 },
+		keyPredicate:   func(key int) bool { return key < 5 },
+		valuePredicate: func(value float64) bool { return value > 3 },
+		count:          2,
+		any:            true,
+		all:            false,
+		none:           false,
+	},
+	{
+		name:           "all_match",
+		m:              Map[int, float64]{1: 2, 3: 4},
+		predicate:      func(key int, value float64) bool { return float64(key) < value },
+		keyPredicate:   func(key int) bool { return key%2 == 1 },
+		valuePredicate: func(value float64) bool { return value > 0 },
+		count:          2,
+		any:            true,
+		all:            true,
+		none:           false,
+	},
+}
+
+func TestM_Count(t *testing.T) {
+	for _, tt := range aggregateTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.count, tt.m.Count(tt.predicate))
+			assert.Equal(t, tt.count, Count(tt.m, tt.predicate))
+			assert.Equal(t, tt.count, tt.m.CountByKey(tt.keyPredicate))
+			assert.Equal(t, tt.count, CountByKey(tt.m, tt.keyPredicate))
+			assert.Equal(t, tt.count, tt.m.CountByValue(tt.valuePredicate))
+			assert.Equal(t, tt.count, CountByValue(tt.m, tt.valuePredicate))
+		})
+	}
+}
+
+func TestM_Any(t *testing.T) {
+	for _, tt := range aggregateTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.any, tt.m.Any(tt.predicate))
+			assert.Equal(t, tt.any, Any(tt.m, tt.predicate))
+			assert.Equal(t, tt.any, tt.m.AnyByKey(tt.keyPredicate))
+			assert.Equal(t, tt.any, AnyByKey(tt.m, tt.keyPredicate))
+			assert.Equal(t, tt.any, tt.m.AnyByValue(tt.valuePredicate))
+			assert.Equal(t, tt.any, AnyByValue(tt.m, tt.valuePredicate))
+		})
+	}
+}
+
+func TestM_All(t *testing.T) {
+	for _, tt := range aggregateTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.all, tt.m.All(tt.predicate))
+			assert.Equal(t, tt.all, All(tt.m, tt.predicate))
+			assert.Equal(t, tt.all, tt.m.AllByKey(tt.keyPredicate))
+			assert.Equal(t, tt.all, AllByKey(tt.m, tt.keyPredicate))
+			assert.Equal(t, tt.all, tt.m.AllByValue(tt.valuePredicate))
+			assert.Equal(t, tt.all, AllByValue(tt.m, tt.valuePredicate))
+		})
+	}
+}
+
+func TestM_None(t *testing.T) {
+	for _, tt := range aggregateTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.none, tt.m.None(tt.predicate))
+			assert.Equal(t, tt.none, None(tt.m, tt.predicate))
+			assert.Equal(t, tt.none, tt.m.NoneByKey(tt.keyPredicate))
+			assert.Equal(t, tt.none, NoneByKey(tt.m, tt.keyPredicate))
+			assert.Equal(t, tt.none, tt.m.NoneByValue(tt.valuePredicate))
+			assert.Equal(t, tt.none, NoneByValue(tt.m, tt.valuePredicate))
+		})
+	}
+}
+
+func TestSumValues(t *testing.T) {
+	assert.Equal(t, 0, SumValues(Map[string, int](nil)))
+	assert.Equal(t, 6, SumValues(Map[string, int]{"a": 1, "b": 2, "c": 3}))
+	assert.Equal(t, 1.5, SumValues(Map[string, float64]{"a": 1, "b": 0.5}))
+}
+
+func TestMinMaxValue(t *testing.T) {
+	_, found := MinValue(Map[string, int](nil))
+	assert.False(t, found)
+	_, found = MaxValue(Map[string, int]{})
+	assert.False(t, found)
+
+	m := Map[string, int]{"a": 3, "b": 1, "c": 5, "d": 2}
+
+	entry, found := MinValue(m)
+	assert.True(t, found)
+	assert.Equal(t, NewEntry("b", 1), entry)
+
+	entry, found = MaxValue(m)
+	assert.True(t, found)
+	assert.Equal(t, NewEntry("c", 5), entry)
+
+	stringEntry, found := MaxValue(Map[int, string]{1: "a", 2: "c", 3: "b"})
+	assert.True(t, found)
+	assert.Equal(t, NewEntry(2, "c"), stringEntry)
+}
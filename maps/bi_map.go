@@ -0,0 +1,266 @@
+package maps
+
+// DuplicateValuePolicy defines how bidirectional map handles value that is already stored by another key
+type DuplicateValuePolicy int
+
+const (
+	// RejectDuplicateValue keeps existing entry and rejects new one
+	RejectDuplicateValue DuplicateValuePolicy = iota
+	// ReplaceDuplicateValue removes existing entry and stores new one
+	ReplaceDuplicateValue
+)
+
+// BiMap represents generic bidirectional map with unique keys and unique values, zero value is an empty map ready to
+// use that rejects duplicate values
+type BiMap[K, V comparable] struct {
+	forward Map[K, V]
+	inverse Map[V, K]
+	policy  DuplicateValuePolicy
+}
+
+// NewBiMap creates new empty bidirectional map with specified duplicate value policy
+func NewBiMap[K, V comparable](policy DuplicateValuePolicy) *BiMap[K, V] {
+	return &BiMap[K, V]{
+		policy: policy,
+	}
+}
+
+// BiFromMap creates new bidirectional map with specified duplicate value policy filled with entries of specified map,
+// keys with duplicate values are handled by policy in no defined order
+func BiFromMap[K, V comparable](m map[K]V, policy DuplicateValuePolicy) *BiMap[K, V] {
+	biMap := NewBiMap[K, V](policy)
+	for key, value := range m {
+		biMap.Put(key, value)
+	}
+	return biMap
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Len returns number of entries in this map
+func (m *BiMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.forward)
+}
+
+// Get returns value stored by key and true if key is present
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	if m == nil {
+		var empty V
+		return empty, false
+	}
+
+	value, found := m.forward[key]
+	return value, found
+}
+
+// GetKey returns key that stores value and true if value is present
+func (m *BiMap[K, V]) GetKey(value V) (K, bool) {
+	if m == nil {
+		var empty K
+		return empty, false
+	}
+
+	key, found := m.inverse[value]
+	return key, found
+}
+
+// Put stores value by key, if value is already stored by another key it is handled by policy of this map, returns
+// false if entry was rejected
+func (m *BiMap[K, V]) Put(key K, value V) bool {
+	if owner, found := m.inverse[value]; found {
+		if owner == key {
+			return true
+		}
+
+		if m.policy == RejectDuplicateValue {
+			return false
+		}
+		delete(m.forward, owner)
+	}
+
+	if old, found := m.forward[key]; found {
+		delete(m.inverse, old)
+	}
+
+	m.init()
+	m.forward[key] = value
+	m.inverse[value] = key
+	return true
+}
+
+// Delete removes key with its value from this map and returns true if key was present
+func (m *BiMap[K, V]) Delete(key K) bool {
+	if m == nil {
+		return false
+	}
+
+	value, found := m.forward[key]
+	if !found {
+		return false
+	}
+
+	delete(m.forward, key)
+	delete(m.inverse, value)
+	return true
+}
+
+// DeleteValue removes value with its key from this map and returns true if value was present
+func (m *BiMap[K, V]) DeleteValue(value V) bool {
+	if m == nil {
+		return false
+	}
+
+	key, found := m.inverse[value]
+	if !found {
+		return false
+	}
+
+	delete(m.forward, key)
+	delete(m.inverse, value)
+	return true
+}
+
+// ContainsKey returns true if key is present in this map
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, found := m.Get(key)
+	return found
+}
+
+// ContainsValue returns true if value is present in this map
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, found := m.GetKey(value)
+	return found
+}
+
+// Inverse returns view of this map with keys and values swapped, changes of view are reflected in this map and
+// vice versa
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if m == nil {
+		return nil
+	}
+
+	m.init()
+	return &BiMap[V, K]{
+		forward: m.inverse,
+		inverse: m.forward,
+		policy:  m.policy,
+	}
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Keys returns keys of this map with no defined order
+func (m *BiMap[K, V]) Keys() []K {
+	if m == nil {
+		return []K{}
+	}
+	return m.forward.Keys()
+}
+
+// Values returns values of this map with no defined order
+func (m *BiMap[K, V]) Values() []V {
+	if m == nil {
+		return []V{}
+	}
+	return m.inverse.Keys()
+}
+
+// Entries returns entries of this map with no defined order
+func (m *BiMap[K, V]) Entries() []Entry[K, V] {
+	if m == nil {
+		return []Entry[K, V]{}
+	}
+	return m.forward.Entries()
+}
+
+// Map returns regular map with entries of this map
+func (m *BiMap[K, V]) Map() ComparableMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	result := make(ComparableMap[K, V], len(m.forward))
+	for key, value := range m.forward {
+		result[key] = value
+	}
+	return result
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Filter returns new map from this, filtered by key and value using provided predicate, policy is preserved
+func (m *BiMap[K, V]) Filter(predicate Predicate[K, V]) *BiMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	filtered := NewBiMap[K, V](m.policy)
+	for key, value := range m.forward {
+		if predicate(key, value) {
+			filtered.Put(key, value)
+		}
+	}
+	return filtered
+}
+
+// FilterSelf removes entries from this map that do not match provided predicate
+func (m *BiMap[K, V]) FilterSelf(predicate Predicate[K, V]) *BiMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	for key, value := range m.forward {
+		if !predicate(key, value) {
+			m.Delete(key)
+		}
+	}
+	return m
+}
+
+// FilterByKey returns new map from this, filtered by key using provided predicate, policy is preserved
+func (m *BiMap[K, V]) FilterByKey(predicate PredicateByKey[K]) *BiMap[K, V] {
+	return m.Filter(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterSelfByKey removes entries from this map which keys do not match provided predicate
+func (m *BiMap[K, V]) FilterSelfByKey(predicate PredicateByKey[K]) *BiMap[K, V] {
+	return m.FilterSelf(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterByValue returns new map from this, filtered by value using provided predicate, policy is preserved
+func (m *BiMap[K, V]) FilterByValue(predicate PredicateByValue[V]) *BiMap[K, V] {
+	return m.Filter(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// FilterSelfByValue removes entries from this map which values do not match provided predicate
+func (m *BiMap[K, V]) FilterSelfByValue(predicate PredicateByValue[V]) *BiMap[K, V] {
+	return m.FilterSelf(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Copy returns shallow copy of this map, policy is preserved
+func (m *BiMap[K, V]) Copy() *BiMap[K, V] {
+	return m.Filter(func(_ K, _ V) bool {
+		return true
+	})
+}
+
+// init initializes underlying maps of this map if they are not initialized yet
+func (m *BiMap[K, V]) init() {
+	if m.forward == nil {
+		m.forward = make(Map[K, V])
+		m.inverse = make(Map[V, K])
+	}
+}
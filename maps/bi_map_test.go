@@ -0,0 +1,131 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_PutGetDelete(t *testing.T) {
+	var m BiMap[string, int]
+	assert.Equal(t, 0, m.Len())
+
+	assert.True(t, m.Put("a", 1))
+	assert.True(t, m.Put("b", 2))
+	assert.True(t, m.Put("a", 1))
+
+	value, found := m.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	key, found := m.GetKey(2)
+	assert.True(t, found)
+	assert.Equal(t, "b", key)
+
+	_, found = m.GetKey(3)
+	assert.False(t, found)
+
+	assert.True(t, m.Put("a", 3))
+	assert.False(t, m.ContainsValue(1))
+	assert.True(t, m.ContainsValue(3))
+	assert.Equal(t, 2, m.Len())
+
+	assert.True(t, m.Delete("a"))
+	assert.False(t, m.Delete("a"))
+	assert.False(t, m.ContainsValue(3))
+
+	assert.True(t, m.DeleteValue(2))
+	assert.False(t, m.DeleteValue(2))
+	assert.False(t, m.ContainsKey("b"))
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestBiMap_Policy(t *testing.T) {
+	reject := NewBiMap[string, int](RejectDuplicateValue)
+	assert.True(t, reject.Put("a", 1))
+	assert.False(t, reject.Put("b", 1))
+	assert.Equal(t, ComparableMap[string, int]{"a": 1}, reject.Map())
+
+	replace := NewBiMap[string, int](ReplaceDuplicateValue)
+	assert.True(t, replace.Put("a", 1))
+	assert.True(t, replace.Put("b", 2))
+	assert.True(t, replace.Put("b", 1))
+	assert.Equal(t, ComparableMap[string, int]{"b": 1}, replace.Map())
+
+	key, found := replace.GetKey(1)
+	assert.True(t, found)
+	assert.Equal(t, "b", key)
+	assert.False(t, replace.ContainsValue(2))
+
+	m := BiFromMap(map[string]int{"a": 1, "b": 1, "c": 2}, RejectDuplicateValue)
+	assert.Equal(t, 2, m.Len())
+	assert.True(t, m.ContainsKey("c"))
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	var m BiMap[string, int]
+	inverse := m.Inverse()
+
+	assert.True(t, inverse.Put(1, "a"))
+	value, found := m.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	assert.True(t, m.Put("b", 2))
+	key, found := inverse.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "b", key)
+
+	assert.False(t, inverse.Put(3, "a"))
+	assert.Equal(t, ComparableMap[int, string]{1: "a", 2: "b"}, inverse.Map())
+	assert.Equal(t, m.Map(), inverse.Inverse().Map())
+}
+
+func TestBiMap_Nil(t *testing.T) {
+	var m *BiMap[string, int]
+
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.ContainsKey("a"))
+	assert.False(t, m.ContainsValue(1))
+	assert.False(t, m.Delete("a"))
+	assert.False(t, m.DeleteValue(1))
+	assert.Equal(t, []string{}, m.Keys())
+	assert.Equal(t, []int{}, m.Values())
+	assert.Equal(t, []Entry[string, int]{}, m.Entries())
+	assert.Nil(t, m.Inverse())
+	assert.Nil(t, m.Copy())
+	assert.Nil(t, m.Map())
+	assert.Nil(t, m.Filter(func(_ string, _ int) bool { return true }))
+	assert.Nil(t, m.FilterSelf(func(_ string, _ int) bool { return true }))
+
+	_, found := m.Get("a")
+	assert.False(t, found)
+	_, found = m.GetKey(1)
+	assert.False(t, found)
+}
+
+func TestBiMap_Filter(t *testing.T) {
+	m := BiFromMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}, ReplaceDuplicateValue)
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	filtered := m.FilterByValue(isEven)
+	assert.Equal(t, ComparableMap[string, int]{"b": 2, "d": 4}, filtered.Map())
+	assert.Equal(t, 4, m.Len())
+	assert.True(t, filtered.Put("e", 2))
+
+	assert.Equal(t, ComparableMap[string, int]{"a": 1}, m.FilterByKey(func(key string) bool {
+		return key == "a"
+	}).Map())
+
+	copyMap := m.Copy()
+	copyMap.FilterSelfByKey(func(key string) bool { return key != "a" })
+	assert.ElementsMatch(t, []string{"b", "c", "d"}, copyMap.Keys())
+	assert.ElementsMatch(t, []int{2, 3, 4}, copyMap.Values())
+	assert.Equal(t, 4, m.Len())
+
+	m.FilterSelfByValue(isEven)
+	assert.ElementsMatch(t, []Entry[string, int]{{Key: "b", Value: 2}, {Key: "d", Value: 4}}, m.Entries())
+	assert.False(t, m.ContainsValue(1))
+	_, found := m.GetKey(3)
+	assert.False(t, found)
+}
@@ -1,5 +1,11 @@
 package maps
 
+import "github.com/mymmrac/aki/types"
+
+// Clone returns new map from this with the same keys and cloned values, panics if Clone of value returns value of
+// other type
+//
+// Deprecated: Use ClonerMap.Clone, it clones values without type assertions
 func (m CloneableMap[K, T, V]) Clone() CloneableMap[K, T, V] {
 	if m == nil {
 		return nil
@@ -11,3 +17,43 @@ func (m CloneableMap[K, T, V]) Clone() CloneableMap[K, T, V] {
 	}
 	return copyMap
 }
+
+// EqualFunc returns true if this and other maps contain the same keys with values equal by provided function, nil
+// and empty maps are equal
+func (m CloneableMap[K, T, V]) EqualFunc(other CloneableMap[K, T, V], equal func(value, otherValue V) bool) bool {
+	return Map[K, V](m).EqualFunc(Map[K, V](other), equal)
+}
+
+// EqualCloneable returns true if specified maps contain the same keys with values equal by provided function, nil
+// and empty maps are equal
+func EqualCloneable[K comparable, T any, V types.Cloneable[T]](
+	this, other CloneableMap[K, T, V], equal func(value, otherValue V) bool,
+) bool {
+	return this.EqualFunc(other, equal)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Clone returns new map from this with the same keys and cloned values
+func (m ClonerMap[K, V]) Clone() ClonerMap[K, V] {
+	return ClonerMap[K, V](CloneMapValues(m))
+}
+
+// CloneMapValues returns new map from specified with the same keys and cloned values
+func CloneMapValues[K comparable, V types.Cloner[V]](m map[K]V) Map[K, V] {
+	return DeepCopy(m)
+}
+
+// EqualFunc returns true if this and other maps contain the same keys with values equal by provided function, nil
+// and empty maps are equal
+func (m ClonerMap[K, V]) EqualFunc(other ClonerMap[K, V], equal func(value, otherValue V) bool) bool {
+	return Map[K, V](m).EqualFunc(Map[K, V](other), equal)
+}
+
+// EqualCloner returns true if specified maps contain the same keys with values equal by provided function, nil and
+// empty maps are equal
+func EqualCloner[K comparable, V types.Cloner[V]](
+	this, other ClonerMap[K, V], equal func(value, otherValue V) bool,
+) bool {
+	return this.EqualFunc(other, equal)
+}
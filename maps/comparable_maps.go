@@ -1,6 +1,9 @@
 package maps
 
-import "github.com/mymmrac/aki/types"
+import (
+	"github.com/mymmrac/aki/option"
+	"github.com/mymmrac/aki/types"
+)
 
 func (m ComparableMap[K, V]) Contains(value V) bool {
 	for _, mapValue := range m {
@@ -29,3 +32,68 @@ func (m ComparableMap[K, V]) FindKeyOf(value V) (K, bool) {
 func FindKeyOf[K, V comparable](m ComparableMap[K, V], value V) (K, bool) {
 	return m.FindKeyOf(value)
 }
+
+// FindKeyOfOption returns key of value in this map as option, none if value is not present, if multiple keys have
+// the same value, arbitrary one of them is returned
+func (m ComparableMap[K, V]) FindKeyOfOption(value V) option.Option[K] {
+	return option.FromPair(m.FindKeyOf(value))
+}
+
+// FindKeyOfOption returns key of value in specified map as option, none if value is not present, if multiple keys
+// have the same value, arbitrary one of them is returned
+func FindKeyOfOption[K, V comparable](m ComparableMap[K, V], value V) option.Option[K] {
+	return m.FindKeyOfOption(value)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Invert returns new map with keys and values of this swapped, if multiple keys have the same value, arbitrary one
+// of them is kept and such values are returned as collisions (each collided value is reported once)
+func (m ComparableMap[K, V]) Invert() (inverted ComparableMap[V, K], collisions []V) {
+	if m == nil {
+		return nil, nil
+	}
+
+	inverted = make(ComparableMap[V, K], len(m))
+	collided := make(map[V]struct{})
+	for key, value := range m {
+		if _, found := inverted[value]; found {
+			if _, reported := collided[value]; !reported {
+				collided[value] = struct{}{}
+				collisions = append(collisions, value)
+			}
+			continue
+		}
+
+		inverted[value] = key
+	}
+	return inverted, collisions
+}
+
+// Invert returns new map with keys and values of specified swapped, if multiple keys have the same value, arbitrary
+// one of them is kept and such values are returned as collisions (each collided value is reported once)
+func Invert[K, V comparable](m ComparableMap[K, V]) (inverted ComparableMap[V, K], collisions []V) {
+	return m.Invert()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Equal returns true if this and other maps contain the same keys with equal values, nil and empty maps are equal
+func (m ComparableMap[K, V]) Equal(other ComparableMap[K, V]) bool {
+	if len(m) != len(other) {
+		return false
+	}
+
+	for key, value := range m {
+		otherValue, found := other[key]
+		if !found || value != otherValue {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if specified maps contain the same keys with equal values, nil and empty maps are equal
+func Equal[K, V comparable](this, other ComparableMap[K, V]) bool {
+	return this.Equal(other)
+}
@@ -0,0 +1,150 @@
+package maps
+
+import (
+	"reflect" //nolint:depguard // Used as baseline in benchmarks
+	"strconv"
+	"testing"
+
+	"github.com/mymmrac/aki/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCM_Invert(t *testing.T) {
+	tests := []struct {
+		name       string
+		m          ComparableMap[string, int]
+		inverted   ComparableMap[int, string]
+		collisions []int
+	}{
+		{
+			name:       "nil",
+			m:          nil,
+			inverted:   nil,
+			collisions: nil,
+		},
+		{
+			name:       "empty",
+			m:          ComparableMap[string, int]{},
+			inverted:   ComparableMap[int, string]{},
+			collisions: nil,
+		},
+		{
+			name:       "unique",
+			m:          ComparableMap[string, int]{"a": 1, "b": 2},
+			inverted:   ComparableMap[int, string]{1: "a", 2: "b"},
+			collisions: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inverted, collisions := tt.m.Invert()
+			assert.Equal(t, tt.inverted, inverted)
+			assert.Equal(t, tt.collisions, collisions)
+
+			inverted, collisions = Invert(tt.m)
+			assert.Equal(t, tt.inverted, inverted)
+			assert.Equal(t, tt.collisions, collisions)
+		})
+	}
+
+	t.Run("collisions", func(t *testing.T) {
+		inverted, collisions := ComparableMap[string, int]{"a": 1, "b": 1, "c": 1, "d": 2}.Invert()
+		assert.Equal(t, []int{1}, collisions)
+		assert.Len(t, inverted, 2)
+		assert.Contains(t, []string{"a", "b", "c"}, inverted[1])
+		assert.Equal(t, "d", inverted[2])
+	})
+}
+
+func TestCM_FindKeyOfOption(t *testing.T) {
+	m := ComparableMap[string, int]{"a": 0, "b": 2}
+	assert.Equal(t, option.Some("a"), m.FindKeyOfOption(0))
+	assert.Equal(t, option.Some("b"), FindKeyOfOption(m, 2))
+	assert.Equal(t, option.None[string](), m.FindKeyOfOption(3))
+	assert.Equal(t, option.None[string](), ComparableMap[string, int](nil).FindKeyOfOption(0))
+}
+
+var equalTestCases = []struct {
+	name  string
+	this  ComparableMap[string, int]
+	other ComparableMap[string, int]
+	equal bool
+}{
+	{
+		name:  "nil",
+		this:  nil,
+		other: nil,
+		equal: true,
+	},
+	{
+		name:  "nil_empty",
+		this:  nil,
+		other: ComparableMap[string, int]{},
+		equal: true,
+	},
+	{
+		name:  "equal",
+		this:  ComparableMap[string, int]{"a": 1, "b": 0},
+		other: ComparableMap[string, int]{"b": 0, "a": 1},
+		equal: true,
+	},
+	{
+		name:  "different_length",
+		this:  ComparableMap[string, int]{"a": 1},
+		other: ComparableMap[string, int]{"a": 1, "b": 2},
+		equal: false,
+	},
+	{
+		name:  "different_keys",
+		this:  ComparableMap[string, int]{"a": 0},
+		other: ComparableMap[string, int]{"b": 0},
+		equal: false,
+	},
+	{
+		name:  "different_values",
+		this:  ComparableMap[string, int]{"a": 1, "b": 2},
+		other: ComparableMap[string, int]{"a": 1, "b": 3},
+		equal: false,
+	},
+}
+
+func TestCM_Equal(t *testing.T) {
+	for _, tt := range equalTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.equal, tt.this.Equal(tt.other))
+			assert.Equal(t, tt.equal, tt.other.Equal(tt.this))
+			assert.Equal(t, tt.equal, Equal(tt.this, tt.other))
+		})
+	}
+}
+
+func benchmarkMaps(size int) (ComparableMap[string, int], ComparableMap[string, int]) {
+	this := make(ComparableMap[string, int], size)
+	other := make(ComparableMap[string, int], size)
+	for i := 0; i < size; i++ {
+		this[strconv.Itoa(i)] = i
+		other[strconv.Itoa(i)] = i
+	}
+	return this, other
+}
+
+func BenchmarkCM_Equal(b *testing.B) {
+	this, other := benchmarkMaps(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !this.Equal(other) {
+			b.Fatal("maps must be equal")
+		}
+	}
+}
+
+func BenchmarkCM_Equal_ReflectDeepEqual(b *testing.B) {
+	this, other := benchmarkMaps(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !reflect.DeepEqual(this, other) {
+			b.Fatal("maps must be equal")
+		}
+	}
+}
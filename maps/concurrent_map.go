@@ -0,0 +1,507 @@
+package maps
+
+import (
+	"hash/fnv"
+	"math"
+	"sync"
+
+	"github.com/mymmrac/aki/constraints"
+)
+
+// DefaultShardCount is number of shards used by concurrent map when non-positive shard count provided
+const DefaultShardCount = 32
+
+// Hasher defines function that returns hash of key, equal keys must have equal hashes
+type Hasher[K comparable] func(key K) uint64
+
+// concurrentMapShard represents part of concurrent map guarded by its own lock
+type concurrentMapShard[K comparable, V any] struct {
+	lock sync.RWMutex
+	data Map[K, V]
+}
+
+// ConcurrentMap represents generic map safe for concurrent use, keys are distributed across shards each guarded by
+// separate read-write lock, zero value is an empty map ready to use with default number of shards and hasher
+type ConcurrentMap[K comparable, V any] struct {
+	once   sync.Once
+	shards []*concurrentMapShard[K, V]
+	hasher Hasher[K]
+}
+
+// NewConcurrentMap creates new empty concurrent map with specified number of shards, if shard count is not
+// positive DefaultShardCount is used.
+//
+// Keys are distributed across shards only if key type is one of built-in basic types (string, bool, integer or
+// float types), keys of other types (named types like `type ID string`, structs, arrays, pointers, interfaces) can't
+// be hashed without provided hasher, so map of such keys uses single shard, see NewConcurrentMapWithHasher
+func NewConcurrentMap[K comparable, V any](shardCount int) *ConcurrentMap[K, V] {
+	return NewConcurrentMapWithHasher[K, V](shardCount, nil)
+}
+
+// NewConcurrentMapWithHasher creates new empty concurrent map with specified number of shards and hasher used to
+// distribute keys across shards, if shard count is not positive DefaultShardCount is used, if hasher is nil default
+// one is used (see NewConcurrentMap), HashString, HashInteger, HashFloat and HashBool can be used as hashers of named
+// types
+func NewConcurrentMapWithHasher[K comparable, V any](shardCount int, hasher Hasher[K]) *ConcurrentMap[K, V] {
+	m := &ConcurrentMap[K, V]{}
+	m.init(shardCount, hasher)
+	return m
+}
+
+// ConcurrentFromMap creates new concurrent map with default number of shards filled with values of specified map
+func ConcurrentFromMap[K comparable, V any](m Map[K, V]) *ConcurrentMap[K, V] {
+	concurrentMap := NewConcurrentMap[K, V](DefaultShardCount)
+	for key, value := range m {
+		concurrentMap.Store(key, value)
+	}
+	return concurrentMap
+}
+
+// init creates shards of this map once, default hasher is used if provided one is nil, single shard is used if key
+// type can't be hashed by default hasher
+func (m *ConcurrentMap[K, V]) init(shardCount int, hasher Hasher[K]) {
+	m.once.Do(func() {
+		if shardCount <= 0 {
+			shardCount = DefaultShardCount
+		}
+
+		if hasher == nil {
+			var empty K
+			if _, ok := builtinHash(empty); !ok {
+				shardCount = 1
+			}
+			hasher = hashKey[K]
+		}
+
+		m.shards = make([]*concurrentMapShard[K, V], shardCount)
+		for i := range m.shards {
+			m.shards[i] = &concurrentMapShard[K, V]{
+				data: make(Map[K, V]),
+			}
+		}
+		m.hasher = hasher
+	})
+}
+
+// allShards returns shards of this map, initializing them with defaults if map was not initialized yet
+func (m *ConcurrentMap[K, V]) allShards() []*concurrentMapShard[K, V] {
+	m.init(DefaultShardCount, nil)
+	return m.shards
+}
+
+// shard returns shard responsible for specified key
+func (m *ConcurrentMap[K, V]) shard(key K) *concurrentMapShard[K, V] {
+	shards := m.allShards()
+	if len(shards) == 1 {
+		return shards[0]
+	}
+	return shards[m.hasher(key)%uint64(len(shards))]
+}
+
+// empty creates new empty map with the same number of shards and hasher as this map
+func (m *ConcurrentMap[K, V]) empty() *ConcurrentMap[K, V] {
+	shards := m.allShards()
+	return NewConcurrentMapWithHasher[K, V](len(shards), m.hasher)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// HashString returns hash of key of any string type
+func HashString[K ~string](key K) uint64 {
+	return hashString(string(key))
+}
+
+// HashInteger returns hash of key of any integer type
+func HashInteger[K constraints.Integer](key K) uint64 {
+	return mixHash(uint64(key))
+}
+
+// HashFloat returns hash of key of any float type, positive and negative zeros have the same hash
+func HashFloat[K constraints.Float](key K) uint64 {
+	return mixHash(math.Float64bits(float64(key) + 0)) // Adding zero turns negative zero into positive one
+}
+
+// HashBool returns hash of key of any bool type
+func HashBool[K ~bool](key K) uint64 {
+	if key {
+		return 1
+	}
+	return 0
+}
+
+// hashKey returns hash of key of built-in basic type, equal keys always have equal hashes, keys of other types have
+// the same hash
+func hashKey[K comparable](key K) uint64 {
+	hash, _ := builtinHash(key)
+	return hash
+}
+
+// builtinHash returns hash of key and true if key has built-in basic type
+func builtinHash[K comparable](key K) (uint64, bool) {
+	switch k := any(key).(type) {
+	case int:
+		return HashInteger(k), true
+	case int8:
+		return HashInteger(k), true
+	case int16:
+		return HashInteger(k), true
+	case int32:
+		return HashInteger(k), true
+	case int64:
+		return HashInteger(k), true
+	case uint:
+		return HashInteger(k), true
+	case uint8:
+		return HashInteger(k), true
+	case uint16:
+		return HashInteger(k), true
+	case uint32:
+		return HashInteger(k), true
+	case uint64:
+		return HashInteger(k), true
+	case uintptr:
+		return HashInteger(k), true
+	case float32:
+		return HashFloat(k), true
+	case float64:
+		return HashFloat(k), true
+	case bool:
+		return HashBool(k), true
+	case string:
+		return HashString(k), true
+	default:
+		return 0, false
+	}
+}
+
+// mixHash spreads bits of integer keys, so sequential keys do not end up in neighbour shards only
+func mixHash(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// hashString returns FNV-1a hash of string
+func hashString(s string) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(s))
+	return hash.Sum64()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Load returns value stored by key and true if key is present
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	shard := m.shard(key)
+	shard.lock.RLock()
+	defer shard.lock.RUnlock()
+
+	value, found := shard.data[key]
+	return value, found
+}
+
+// Store sets value by key
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	shard := m.shard(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+
+	shard.data[key] = value
+}
+
+// Delete removes key from this map and returns true if key was present
+func (m *ConcurrentMap[K, V]) Delete(key K) bool {
+	_, found := m.LoadAndDelete(key)
+	return found
+}
+
+// LoadAndDelete removes key from this map and returns its previous value and true if key was present
+func (m *ConcurrentMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	shard := m.shard(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+
+	value, found := shard.data[key]
+	delete(shard.data, key)
+	return value, found
+}
+
+// LoadOrStore atomically returns existing value by key if present, otherwise stores and returns provided value,
+// loaded result is true if value was loaded and false if stored
+func (m *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	shard := m.shard(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+
+	if existing, found := shard.data[key]; found {
+		return existing, true
+	}
+
+	shard.data[key] = value
+	return value, false
+}
+
+// Swap atomically stores value by key and returns previous value and true if key was present
+func (m *ConcurrentMap[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	shard := m.shard(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+
+	previous, loaded = shard.data[key]
+	shard.data[key] = value
+	return previous, loaded
+}
+
+// Compute atomically computes new value by key using provided function, function receives current value and true
+// if key is present, if function returns false as second result key is removed, returns new value and true if it
+// was stored
+//
+// Note: Function is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) Compute(key K, compute func(value V, found bool) (V, bool)) (V, bool) {
+	shard := m.shard(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+
+	value, found := shard.data[key]
+	newValue, keep := compute(value, found)
+	if !keep {
+		delete(shard.data, key)
+		return newValue, false
+	}
+
+	shard.data[key] = newValue
+	return newValue, true
+}
+
+// ComputeIfAbsent atomically returns existing value by key if present, otherwise computes, stores and returns new
+// value using provided function, function is called at most once
+//
+// Note: Function is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) ComputeIfAbsent(key K, compute func(key K) V) V {
+	shard := m.shard(key)
+
+	shard.lock.RLock()
+	value, found := shard.data[key]
+	shard.lock.RUnlock()
+	if found {
+		return value
+	}
+
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+
+	if value, found = shard.data[key]; found {
+		return value
+	}
+
+	value = compute(key)
+	shard.data[key] = value
+	return value
+}
+
+// ContainsKey returns true if key is present in this map
+func (m *ConcurrentMap[K, V]) ContainsKey(key K) bool {
+	_, found := m.Load(key)
+	return found
+}
+
+// Len returns number of entries in this map
+func (m *ConcurrentMap[K, V]) Len() int {
+	length := 0
+	for _, shard := range m.allShards() {
+		shard.lock.RLock()
+		length += len(shard.data)
+		shard.lock.RUnlock()
+	}
+	return length
+}
+
+// Range calls function for each entry of this map until it returns false, each shard is iterated over its snapshot,
+// so function can safely access this map
+func (m *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
+	for _, shard := range m.allShards() {
+		shard.lock.RLock()
+		entries := shard.data.Entries()
+		shard.lock.RUnlock()
+
+		for _, entry := range entries {
+			if !f(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Keys returns keys of this map with no defined order
+func (m *ConcurrentMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for _, shard := range m.allShards() {
+		shard.lock.RLock()
+		for key := range shard.data {
+			keys = append(keys, key)
+		}
+		shard.lock.RUnlock()
+	}
+	return keys
+}
+
+// Values returns values of this map with no defined order
+func (m *ConcurrentMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for _, shard := range m.allShards() {
+		shard.lock.RLock()
+		for _, value := range shard.data {
+			values = append(values, value)
+		}
+		shard.lock.RUnlock()
+	}
+	return values
+}
+
+// Entries returns entries of this map with no defined order
+func (m *ConcurrentMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	for _, shard := range m.allShards() {
+		shard.lock.RLock()
+		for key, value := range shard.data {
+			entries = append(entries, Entry[K, V]{
+				Key:   key,
+				Value: value,
+			})
+		}
+		shard.lock.RUnlock()
+	}
+	return entries
+}
+
+// FillEntries fills entries into this map
+func (m *ConcurrentMap[K, V]) FillEntries(entries []Entry[K, V]) *ConcurrentMap[K, V] {
+	for _, entry := range entries {
+		m.Store(entry.Key, entry.Value)
+	}
+	return m
+}
+
+// FillEntry fills entries into this map
+func (m *ConcurrentMap[K, V]) FillEntry(entries ...Entry[K, V]) *ConcurrentMap[K, V] {
+	return m.FillEntries(entries)
+}
+
+// Map returns snapshot of this map as regular map
+func (m *ConcurrentMap[K, V]) Map() Map[K, V] {
+	result := make(Map[K, V], m.Len())
+	for _, shard := range m.allShards() {
+		shard.lock.RLock()
+		result.MergeSelf(shard.data)
+		shard.lock.RUnlock()
+	}
+	return result
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Filter returns new map from this, filtered by key and value using provided predicate
+//
+// Note: Predicate is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) Filter(predicate Predicate[K, V]) *ConcurrentMap[K, V] {
+	filtered := m.empty()
+	for i, shard := range m.shards {
+		shard.lock.RLock()
+		filtered.shards[i].data = shard.data.Filter(predicate)
+		shard.lock.RUnlock()
+	}
+	return filtered
+}
+
+// FilterSelf removes entries from this map that do not match provided predicate, each shard is filtered atomically
+//
+// Note: Predicate is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) FilterSelf(predicate Predicate[K, V]) *ConcurrentMap[K, V] {
+	for _, shard := range m.allShards() {
+		shard.lock.Lock()
+		shard.data.FilterSelf(predicate)
+		shard.lock.Unlock()
+	}
+	return m
+}
+
+// FilterByKey returns new map from this, filtered by key using provided predicate
+//
+// Note: Predicate is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) FilterByKey(predicate PredicateByKey[K]) *ConcurrentMap[K, V] {
+	return m.Filter(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterSelfByKey removes entries from this map which keys do not match provided predicate
+//
+// Note: Predicate is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) FilterSelfByKey(predicate PredicateByKey[K]) *ConcurrentMap[K, V] {
+	return m.FilterSelf(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterByValue returns new map from this, filtered by value using provided predicate
+//
+// Note: Predicate is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) FilterByValue(predicate PredicateByValue[V]) *ConcurrentMap[K, V] {
+	return m.Filter(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// FilterSelfByValue removes entries from this map which values do not match provided predicate
+//
+// Note: Predicate is called while shard lock is held, so it must not access this map
+func (m *ConcurrentMap[K, V]) FilterSelfByValue(predicate PredicateByValue[V]) *ConcurrentMap[K, V] {
+	return m.FilterSelf(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Copy returns shallow copy of this map with the same number of shards
+func (m *ConcurrentMap[K, V]) Copy() *ConcurrentMap[K, V] {
+	copyMap := m.empty()
+	for i, shard := range m.shards {
+		shard.lock.RLock()
+		copyMap.shards[i].data = shard.data.Copy()
+		shard.lock.RUnlock()
+	}
+	return copyMap
+}
+
+// Merge returns new map with values from snapshot of provided map merged into copy of this
+func (m *ConcurrentMap[K, V]) Merge(other *ConcurrentMap[K, V]) *ConcurrentMap[K, V] {
+	return m.Copy().MergeSelf(other)
+}
+
+// MergeSelf merges values from snapshot of provided map into this
+func (m *ConcurrentMap[K, V]) MergeSelf(other *ConcurrentMap[K, V]) *ConcurrentMap[K, V] {
+	for key, value := range other.Map() {
+		m.Store(key, value)
+	}
+	return m
+}
+
+// MergeLeft returns new map with values from snapshot of provided map merged into copy of this, keeping values of
+// this on conflicts
+func (m *ConcurrentMap[K, V]) MergeLeft(other *ConcurrentMap[K, V]) *ConcurrentMap[K, V] {
+	return m.Copy().MergeSelfLeft(other)
+}
+
+// MergeSelfLeft merges values from snapshot of provided map into this, keeping values of this on conflicts
+func (m *ConcurrentMap[K, V]) MergeSelfLeft(other *ConcurrentMap[K, V]) *ConcurrentMap[K, V] {
+	for key, value := range other.Map() {
+		m.LoadOrStore(key, value)
+	}
+	return m
+}
@@ -0,0 +1,246 @@
+package maps
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentMap_Basic(t *testing.T) {
+	m := NewConcurrentMap[string, int](0)
+	assert.Len(t, m.shards, DefaultShardCount)
+
+	m.Store("a", 1)
+	value, found := m.Load("a")
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	_, found = m.Load("b")
+	assert.False(t, found)
+
+	actual, loaded := m.LoadOrStore("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, actual)
+
+	actual, loaded = m.LoadOrStore("b", 2)
+	assert.False(t, loaded)
+	assert.Equal(t, 2, actual)
+
+	previous, loaded := m.Swap("b", 3)
+	assert.True(t, loaded)
+	assert.Equal(t, 2, previous)
+
+	_, loaded = m.Swap("c", 4)
+	assert.False(t, loaded)
+
+	assert.Equal(t, 3, m.Len())
+	assert.True(t, m.ContainsKey("c"))
+	assert.True(t, m.Delete("c"))
+	assert.False(t, m.Delete("c"))
+	assert.False(t, m.ContainsKey("c"))
+
+	assert.Equal(t, Map[string, int]{"a": 1, "b": 3}, m.Map())
+	assert.ElementsMatch(t, []string{"a", "b"}, m.Keys())
+	assert.ElementsMatch(t, []int{1, 3}, m.Values())
+	assert.ElementsMatch(t, []Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 3}}, m.Entries())
+}
+
+func TestConcurrentMap_Compute(t *testing.T) {
+	m := NewConcurrentMap[string, int](4)
+
+	value, stored := m.Compute("a", func(value int, found bool) (int, bool) {
+		assert.False(t, found)
+		return value + 1, true
+	})
+	assert.True(t, stored)
+	assert.Equal(t, 1, value)
+
+	value, stored = m.Compute("a", func(value int, found bool) (int, bool) {
+		assert.True(t, found)
+		return value + 1, true
+	})
+	assert.True(t, stored)
+	assert.Equal(t, 2, value)
+
+	_, stored = m.Compute("a", func(_ int, _ bool) (int, bool) {
+		return 0, false
+	})
+	assert.False(t, stored)
+	assert.False(t, m.ContainsKey("a"))
+
+	calls := 0
+	compute := func(key string) int {
+		calls++
+		return len(key)
+	}
+	assert.Equal(t, 3, m.ComputeIfAbsent("abc", compute))
+	assert.Equal(t, 3, m.ComputeIfAbsent("abc", compute))
+	assert.Equal(t, 1, calls)
+}
+
+func TestConcurrentMap_FilterMerge(t *testing.T) {
+	source := Map[int, int]{1: 1, 2: 2, 3: 3, 4: 4}
+	m := ConcurrentFromMap(source)
+
+	assert.Equal(t, Map[int, int]{1: 1, 3: 3},
+		m.Filter(func(key, value int) bool { return key == 1 || value == 3 }).Map())
+	assert.Equal(t, Map[int, int]{2: 2}, m.FilterByKey(func(key int) bool { return key == 2 }).Map())
+	assert.Equal(t, Map[int, int]{4: 4}, m.FilterByValue(func(value int) bool { return value == 4 }).Map())
+	assert.Equal(t, source, m.Map())
+
+	copyMap := m.Copy()
+	copyMap.FilterSelf(func(key, _ int) bool { return key > 1 })
+	copyMap.FilterSelfByKey(func(key int) bool { return key < 4 })
+	copyMap.FilterSelfByValue(func(value int) bool { return value != 3 })
+	assert.Equal(t, Map[int, int]{2: 2}, copyMap.Map())
+	assert.Equal(t, source, m.Map())
+
+	other := ConcurrentFromMap(Map[int, int]{1: 10, 5: 50})
+	assert.Equal(t, Map[int, int]{1: 10, 2: 2, 3: 3, 4: 4, 5: 50}, m.Merge(other).Map())
+	assert.Equal(t, Map[int, int]{1: 1, 2: 2, 3: 3, 4: 4, 5: 50}, m.MergeLeft(other).Map())
+	assert.Equal(t, source, m.Map())
+
+	m.MergeSelfLeft(other)
+	assert.Equal(t, Map[int, int]{1: 1, 2: 2, 3: 3, 4: 4, 5: 50}, m.Map())
+
+	m.MergeSelf(other)
+	assert.Equal(t, Map[int, int]{1: 10, 2: 2, 3: 3, 4: 4, 5: 50}, m.Map())
+
+	m.FillEntry(NewEntry(6, 60))
+	assert.True(t, m.ContainsKey(6))
+
+	count := 0
+	m.Range(func(_, _ int) bool {
+		count++
+		return count < 2
+	})
+	assert.Equal(t, 2, count)
+}
+
+func TestConcurrentMap_Keys(t *testing.T) {
+	type key struct {
+		a int
+		b string
+	}
+
+	m := NewConcurrentMap[key, int](8)
+	assert.Len(t, m.shards, 1, "keys that can't be hashed use single shard")
+	m.Store(key{a: 1, b: "b"}, 1)
+	assert.True(t, m.ContainsKey(key{a: 1, b: "b"}))
+	assert.False(t, m.ContainsKey(key{a: 1, b: "c"}))
+
+	negativeZero := 0.0
+	negativeZero = -negativeZero
+	assert.Equal(t, hashKey(0.0), hashKey(negativeZero))
+	assert.Equal(t, hashKey(float32(0)), hashKey(float32(negativeZero)))
+
+	assert.NotEqual(t, hashKey(true), hashKey(false))
+	assert.Equal(t, hashKey(int8(1)), hashKey(uint64(1)))
+	assert.NotEqual(t, hashKey(uint16(1)), hashKey(uint32(2)))
+
+	type floatKey struct {
+		f float64
+	}
+
+	floats := NewConcurrentMap[floatKey, int](0)
+	floats.Store(floatKey{f: 0}, 1)
+	floats.Store(floatKey{f: negativeZero}, 2)
+	assert.Equal(t, 1, floats.Len())
+
+	value, found := floats.Load(floatKey{f: 0})
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+}
+
+func TestConcurrentMap_Hasher(t *testing.T) {
+	type userID string
+	type id int
+	type ratio float32
+	type flag bool
+
+	assert.Equal(t, HashString("a"), HashString(userID("a")))
+	assert.Equal(t, HashInteger(1), HashInteger(id(1)))
+	assert.Equal(t, HashFloat(ratio(0)), HashFloat(-ratio(0)))
+	assert.NotEqual(t, HashBool(flag(true)), HashBool(flag(false)))
+
+	m := NewConcurrentMapWithHasher[userID, int](4, HashString[userID])
+	assert.Len(t, m.shards, 4)
+	for i := 0; i < 100; i++ {
+		m.Store(userID(strconv.Itoa(i)), i)
+	}
+	assert.Equal(t, 100, m.Len())
+
+	used := 0
+	for _, shard := range m.shards {
+		if len(shard.data) > 0 {
+			used++
+		}
+	}
+	assert.Equal(t, 4, used)
+
+	filtered := m.FilterByValue(func(value int) bool { return value < 10 })
+	assert.Len(t, filtered.shards, 4)
+	assert.Equal(t, 10, filtered.Len())
+
+	copyMap := m.Copy()
+	value, found := copyMap.Load("42")
+	assert.True(t, found)
+	assert.Equal(t, 42, value)
+
+	assert.Len(t, NewConcurrentMapWithHasher[userID, int](4, nil).shards, 1)
+}
+
+func TestConcurrentMap_ZeroValue(t *testing.T) {
+	var m ConcurrentMap[string, int]
+	assert.Equal(t, 0, m.Len())
+
+	_, found := m.Load("a")
+	assert.False(t, found)
+
+	m.Store("a", 1)
+	assert.Len(t, m.shards, DefaultShardCount)
+	assert.Equal(t, Map[string, int]{"a": 1}, m.Map())
+	assert.Equal(t, Map[string, int]{"a": 1}, m.Copy().Map())
+}
+
+func TestConcurrentMap_Concurrent(t *testing.T) {
+	const (
+		goroutines = 8
+		iterations = 1000
+	)
+
+	m := NewConcurrentMap[string, int](0)
+	wg := sync.WaitGroup{}
+	wg.Add(goroutines)
+
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < iterations; i++ {
+				key := strconv.Itoa(i % 100)
+				m.Compute("counter", func(value int, _ bool) (int, bool) {
+					return value + 1, true
+				})
+				m.LoadOrStore(key, i)
+				m.ComputeIfAbsent(key, func(_ string) int { return g })
+				m.Swap(key+"-swap", i)
+				m.Load(key)
+
+				if i%100 == 0 {
+					m.FilterSelfByKey(func(key string) bool { return key == "counter" || len(key) < 3 })
+					_ = m.Entries()
+					_ = m.Filter(func(_ string, _ int) bool { return true })
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	counter, found := m.Load("counter")
+	assert.True(t, found)
+	assert.Equal(t, goroutines*iterations, counter)
+}
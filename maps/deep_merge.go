@@ -0,0 +1,238 @@
+package maps
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SliceMergeStrategy defines how slices ([]any) present on the same path in both maps are merged by DeepMerge
+type SliceMergeStrategy int
+
+const (
+	// SliceReplace replaces left slice with right one
+	SliceReplace SliceMergeStrategy = iota
+
+	// SliceAppend appends values of right slice to left one
+	SliceAppend
+
+	// SliceUnion appends values of right slice that are not present in left one
+	SliceUnion
+)
+
+// ConflictResolver defines function that resolves type conflict of values on specified path during deep merge, for
+// example, when nested map is merged with a string
+type ConflictResolver func(path []string, left, right any) (any, error)
+
+// ConflictError represents type conflict of values on specified path during deep merge
+type ConflictError struct {
+	Path  []string
+	Left  any
+	Right any
+}
+
+// Error returns description of conflict
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("deep merge: type conflict at %q: %T and %T", strings.Join(e.Path, "."), e.Left, e.Right)
+}
+
+// DeepMergeOption defines option of DeepMerge
+type DeepMergeOption func(options *deepMergeOptions)
+
+// deepMergeOptions represents options of DeepMerge
+type deepMergeOptions struct {
+	sliceStrategy    SliceMergeStrategy
+	conflictResolver ConflictResolver
+}
+
+// WithSliceStrategy sets strategy of merging slices, by default SliceReplace is used
+func WithSliceStrategy(strategy SliceMergeStrategy) DeepMergeOption {
+	return func(options *deepMergeOptions) {
+		options.sliceStrategy = strategy
+	}
+}
+
+// WithConflictResolver sets resolver of type conflicts, by default *ConflictError is returned on type conflict
+func WithConflictResolver(resolver ConflictResolver) DeepMergeOption {
+	return func(options *deepMergeOptions) {
+		options.conflictResolver = resolver
+	}
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// DeepMerge returns new map with values from right map recursively merged into left one, nested map[string]any and
+// Map[string, any] values are merged key by key, slices ([]any) are merged using slice strategy and other values
+// are replaced by right ones, type conflict (map or slice merged with a value of different kind) is resolved by
+// conflict resolver
+//
+// Note: Neither of specified maps is modified, but values that were not merged are not copied
+func DeepMerge(left, right Map[string, any], options ...DeepMergeOption) (Map[string, any], error) {
+	mergeOptions := &deepMergeOptions{
+		sliceStrategy: SliceReplace,
+	}
+	for _, option := range options {
+		option(mergeOptions)
+	}
+
+	merged, err := deepMergeMaps(nil, left, right, mergeOptions)
+	if err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// deepMergeMaps returns new map with values of right map recursively merged into left one
+func deepMergeMaps(path []string, left, right map[string]any, options *deepMergeOptions) (map[string]any, error) {
+	merged := make(map[string]any, len(left)+len(right))
+	for key, value := range left {
+		merged[key] = value
+	}
+
+	for key, rightValue := range right {
+		leftValue, found := left[key]
+		if !found {
+			merged[key] = rightValue
+			continue
+		}
+
+		keyPath := make([]string, len(path), len(path)+1)
+		copy(keyPath, path)
+		keyPath = append(keyPath, key)
+
+		value, err := deepMergeValues(keyPath, leftValue, rightValue, options)
+		if err != nil {
+			return nil, err
+		}
+		merged[key] = value
+	}
+
+	return merged, nil
+}
+
+// deepMergeValues returns result of merging right value into left one on specified path
+func deepMergeValues(path []string, left, right any, options *deepMergeOptions) (any, error) {
+	leftMap, leftIsMap := asStringMap(left)
+	rightMap, rightIsMap := asStringMap(right)
+	if leftIsMap && rightIsMap {
+		merged, err := deepMergeMaps(path, leftMap, rightMap, options)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := left.(Map[string, any]); ok {
+			return Map[string, any](merged), nil
+		}
+		return merged, nil
+	}
+
+	leftSlice, leftIsSlice := left.([]any)
+	rightSlice, rightIsSlice := right.([]any)
+	if leftIsSlice && rightIsSlice {
+		return mergeSlices(leftSlice, rightSlice, options.sliceStrategy), nil
+	}
+
+	if left == nil || right == nil || !(leftIsMap || rightIsMap || leftIsSlice || rightIsSlice) {
+		return right, nil
+	}
+
+	if options.conflictResolver != nil {
+		return options.conflictResolver(path, left, right)
+	}
+
+	return nil, &ConflictError{
+		Path:  path,
+		Left:  left,
+		Right: right,
+	}
+}
+
+// mergeSlices returns new slice from specified slices merged using provided strategy
+func mergeSlices(left, right []any, strategy SliceMergeStrategy) []any {
+	switch strategy {
+	case SliceAppend:
+		merged := make([]any, 0, len(left)+len(right))
+		merged = append(merged, left...)
+		return append(merged, right...)
+	case SliceUnion:
+		merged := make([]any, 0, len(left)+len(right))
+		merged = append(merged, left...)
+		for _, value := range right {
+			if !containsDeepEqual(merged, value) {
+				merged = append(merged, value)
+			}
+		}
+		return merged
+	default:
+		return right
+	}
+}
+
+// asStringMap returns value as map[string]any and true if value is map[string]any or Map[string, any]
+func asStringMap(value any) (map[string]any, bool) {
+	switch m := value.(type) {
+	case map[string]any:
+		return m, true
+	case Map[string, any]:
+		return m, true
+	default:
+		return nil, false
+	}
+}
+
+// containsDeepEqual returns true if slice contains value deeply equal to provided one
+func containsDeepEqual(values []any, value any) bool {
+	for _, v := range values {
+		if deepEqualValues(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// deepEqualValues returns true if values are deeply equal, nested maps and slices are compared element by element,
+// other values are compared using ==, values of non-comparable types are never equal
+func deepEqualValues(left, right any) bool {
+	leftMap, leftIsMap := asStringMap(left)
+	rightMap, rightIsMap := asStringMap(right)
+	if leftIsMap || rightIsMap {
+		if !leftIsMap || !rightIsMap || len(leftMap) != len(rightMap) {
+			return false
+		}
+
+		for key, leftValue := range leftMap {
+			rightValue, found := rightMap[key]
+			if !found || !deepEqualValues(leftValue, rightValue) {
+				return false
+			}
+		}
+		return true
+	}
+
+	leftSlice, leftIsSlice := left.([]any)
+	rightSlice, rightIsSlice := right.([]any)
+	if leftIsSlice || rightIsSlice {
+		if !leftIsSlice || !rightIsSlice || len(leftSlice) != len(rightSlice) {
+			return false
+		}
+
+		for i := range leftSlice {
+			if !deepEqualValues(leftSlice[i], rightSlice[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return comparableEqual(left, right)
+}
+
+// comparableEqual returns true if values are equal, comparing values of non-comparable types panics, so such
+// values are treated as not equal
+func comparableEqual(left, right any) (equal bool) {
+	defer func() {
+		if recover() != nil {
+			equal = false
+		}
+	}()
+	return left == right
+}
@@ -0,0 +1,151 @@
+package maps
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeepMerge(t *testing.T) {
+	left := Map[string, any]{
+		"name": "app",
+		"server": map[string]any{
+			"host": "localhost",
+			"port": 8080,
+			"tls": Map[string, any]{
+				"enabled": false,
+			},
+		},
+		"tags": []any{"a", "b"},
+	}
+	right := Map[string, any]{
+		"server": map[string]any{
+			"port": 9090,
+			"tls": map[string]any{
+				"enabled": true,
+				"cert":    "cert.pem",
+			},
+		},
+		"tags":  []any{"b", "c"},
+		"debug": true,
+	}
+
+	tests := []struct {
+		name     string
+		strategy SliceMergeStrategy
+		tags     []any
+	}{
+		{name: "replace", strategy: SliceReplace, tags: []any{"b", "c"}},
+		{name: "append", strategy: SliceAppend, tags: []any{"a", "b", "b", "c"}},
+		{name: "union", strategy: SliceUnion, tags: []any{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := DeepMerge(left, right, WithSliceStrategy(tt.strategy))
+			require.NoError(t, err)
+
+			assert.Equal(t, Map[string, any]{
+				"name": "app",
+				"server": map[string]any{
+					"host": "localhost",
+					"port": 9090,
+					"tls": Map[string, any]{
+						"enabled": true,
+						"cert":    "cert.pem",
+					},
+				},
+				"tags":  tt.tags,
+				"debug": true,
+			}, merged)
+		})
+	}
+
+	assert.Equal(t, false, left["server"].(map[string]any)["tls"].(Map[string, any])["enabled"])
+	assert.Equal(t, []any{"a", "b"}, left["tags"])
+}
+
+func TestDeepMerge_Nil(t *testing.T) {
+	merged, err := DeepMerge(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, Map[string, any]{}, merged)
+
+	merged, err = DeepMerge(Map[string, any]{"a": map[string]any{"b": 1}}, Map[string, any]{"a": nil})
+	require.NoError(t, err)
+	assert.Equal(t, Map[string, any]{"a": nil}, merged)
+
+	merged, err = DeepMerge(Map[string, any]{"a": nil}, Map[string, any]{"a": []any{1}})
+	require.NoError(t, err)
+	assert.Equal(t, Map[string, any]{"a": []any{1}}, merged)
+
+	merged, err = DeepMerge(Map[string, any]{"a": 1}, Map[string, any]{"a": "b"})
+	require.NoError(t, err)
+	assert.Equal(t, Map[string, any]{"a": "b"}, merged)
+}
+
+func TestDeepMerge_Conflict(t *testing.T) {
+	left := Map[string, any]{"a": map[string]any{"b": map[string]any{"c": 1}}}
+	right := Map[string, any]{"a": map[string]any{"b": "value"}}
+
+	_, err := DeepMerge(left, right)
+	var conflictErr *ConflictError
+	require.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, []string{"a", "b"}, conflictErr.Path)
+	assert.Equal(t, "value", conflictErr.Right)
+	assert.Equal(t, `deep merge: type conflict at "a.b": map[string]interface {} and string`, err.Error())
+
+	_, err = DeepMerge(Map[string, any]{"a": []any{1}}, Map[string, any]{"a": 1})
+	assert.Error(t, err)
+
+	var conflictPath []string
+	merged, err := DeepMerge(left, right, WithConflictResolver(func(path []string, left, _ any) (any, error) {
+		conflictPath = path
+		return left, nil
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, conflictPath)
+	assert.Equal(t, left, merged)
+
+	resolverErr := errors.New("resolver error")
+	_, err = DeepMerge(left, right, WithConflictResolver(func(_ []string, _, _ any) (any, error) {
+		return nil, resolverErr
+	}))
+	assert.ErrorIs(t, err, resolverErr)
+}
+
+func TestDeepMerge_Union(t *testing.T) {
+	left := Map[string, any]{"a": []any{
+		map[string]any{"b": 1},
+		[]any{1, 2},
+		[]int{1},
+		Map[string, any]{"c": []any{1}},
+	}}
+	right := Map[string, any]{"a": []any{
+		map[string]any{"b": 1},
+		map[string]any{"b": 2},
+		map[string]any{"d": 1},
+		[]any{1, 2},
+		[]any{2, 1},
+		[]any{1},
+		[]int{1},
+		map[string]any{"c": []any{1}},
+		"e",
+	}}
+
+	merged, err := DeepMerge(left, right, WithSliceStrategy(SliceUnion))
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"b": 1},
+		[]any{1, 2},
+		[]int{1},
+		Map[string, any]{"c": []any{1}},
+		map[string]any{"b": 2},
+		map[string]any{"d": 1},
+		[]any{2, 1},
+		[]any{1},
+		[]int{1},
+		"e",
+	}, merged["a"])
+}
@@ -0,0 +1,111 @@
+package maps
+
+// ValueChange represents change of value stored by the same key
+type ValueChange[V any] struct {
+	Old V
+	New V
+}
+
+// MapDiff represents difference between old and new map, all maps are non-nil
+type MapDiff[K comparable, V any] struct {
+	// Added contains entries present only in new map
+	Added Map[K, V]
+	// Removed contains entries present only in old map
+	Removed Map[K, V]
+	// Changed contains old and new values of keys present in both maps with different values, it's a plain map
+	// since Map[K, ValueChange[V]] would cause instantiation cycle in methods of Map
+	Changed map[K]ValueChange[V]
+}
+
+// Empty returns true if there is no difference
+func (d MapDiff[K, V]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// DiffFunc returns difference between this (old) map and new map, values are compared using provided equal function
+func (m Map[K, V]) DiffFunc(newMap Map[K, V], equal func(oldValue, newValue V) bool) MapDiff[K, V] {
+	diff := MapDiff[K, V]{
+		Added:   make(Map[K, V]),
+		Removed: make(Map[K, V]),
+		Changed: make(map[K]ValueChange[V]),
+	}
+
+	for key, oldValue := range m {
+		newValue, found := newMap[key]
+		if !found {
+			diff.Removed[key] = oldValue
+			continue
+		}
+
+		if !equal(oldValue, newValue) {
+			diff.Changed[key] = ValueChange[V]{
+				Old: oldValue,
+				New: newValue,
+			}
+		}
+	}
+
+	for key, newValue := range newMap {
+		if _, found := m[key]; !found {
+			diff.Added[key] = newValue
+		}
+	}
+
+	return diff
+}
+
+// DiffFunc returns difference between old and new maps, values are compared using provided equal function
+func DiffFunc[K comparable, V any](
+	oldMap, newMap Map[K, V], equal func(oldValue, newValue V) bool,
+) MapDiff[K, V] {
+	return oldMap.DiffFunc(newMap, equal)
+}
+
+// Diff returns difference between this (old) map and new map, values are compared using ==
+func (m ComparableMap[K, V]) Diff(newMap ComparableMap[K, V]) MapDiff[K, V] {
+	return Map[K, V](m).DiffFunc(Map[K, V](newMap), func(oldValue, newValue V) bool {
+		return oldValue == newValue
+	})
+}
+
+// Diff returns difference between old and new maps, values are compared using ==
+func Diff[K, V comparable](oldMap, newMap ComparableMap[K, V]) MapDiff[K, V] {
+	return oldMap.Diff(newMap)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Patch returns new map from this with diff applied, removed keys are deleted, added and changed keys are set to
+// new values
+func (m Map[K, V]) Patch(diff MapDiff[K, V]) Map[K, V] {
+	patched := m.Copy()
+	if patched == nil {
+		patched = make(Map[K, V])
+	}
+	return patched.PatchSelf(diff)
+}
+
+// PatchSelf applies diff to this map, removed keys are deleted, added and changed keys are set to new values
+func (m Map[K, V]) PatchSelf(diff MapDiff[K, V]) Map[K, V] {
+	for key := range diff.Removed {
+		delete(m, key)
+	}
+
+	for key, value := range diff.Added {
+		m[key] = value
+	}
+
+	for key, change := range diff.Changed {
+		m[key] = change.New
+	}
+
+	return m
+}
+
+// Patch returns new map from specified with diff applied, removed keys are deleted, added and changed keys are set
+// to new values
+func Patch[K comparable, V any](m Map[K, V], diff MapDiff[K, V]) Map[K, V] {
+	return m.Patch(diff)
+}
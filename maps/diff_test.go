@@ -0,0 +1,114 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var diffTestCases = []struct {
+	name    string
+	oldMap  ComparableMap[string, int]
+	newMap  ComparableMap[string, int]
+	added   Map[string, int]
+	removed Map[string, int]
+	changed map[string]ValueChange[int]
+}{
+	{
+		name:    "nil",
+		oldMap:  nil,
+		newMap:  nil,
+		added:   Map[string, int]{},
+		removed: Map[string, int]{},
+		changed: map[string]ValueChange[int]{},
+	},
+	{
+		name:    "equal",
+		oldMap:  ComparableMap[string, int]{"a": 1, "b": 2},
+		newMap:  ComparableMap[string, int]{"a": 1, "b": 2},
+		added:   Map[string, int]{},
+		removed: Map[string, int]{},
+		changed: map[string]ValueChange[int]{},
+	},
+	{
+		name:    "added",
+		oldMap:  nil,
+		newMap:  ComparableMap[string, int]{"a": 1},
+		added:   Map[string, int]{"a": 1},
+		removed: Map[string, int]{},
+		changed: map[string]ValueChange[int]{},
+	},
+	{
+		name:    "removed",
+		oldMap:  ComparableMap[string, int]{"a": 1},
+		newMap:  ComparableMap[string, int]{},
+		added:   Map[string, int]{},
+		removed: Map[string, int]{"a": 1},
+		changed: map[string]ValueChange[int]{},
+	},
+	{
+		name:    "mixed",
+		oldMap:  ComparableMap[string, int]{"a": 1, "b": 2, "c": 3},
+		newMap:  ComparableMap[string, int]{"b": 2, "c": 4, "d": 5},
+		added:   Map[string, int]{"d": 5},
+		removed: Map[string, int]{"a": 1},
+		changed: map[string]ValueChange[int]{"c": {Old: 3, New: 4}},
+	},
+}
+
+func TestCM_Diff(t *testing.T) {
+	for _, tt := range diffTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := MapDiff[string, int]{
+				Added:   tt.added,
+				Removed: tt.removed,
+				Changed: tt.changed,
+			}
+
+			diff := tt.oldMap.Diff(tt.newMap)
+			assert.Equal(t, expected, diff)
+			assert.Equal(t, expected, Diff(tt.oldMap, tt.newMap))
+			assert.Equal(t, len(tt.added)+len(tt.removed)+len(tt.changed) == 0, diff.Empty())
+
+			patched := Patch(Map[string, int](tt.oldMap), diff)
+			assert.Equal(t, len(tt.newMap), len(patched))
+			for key, value := range tt.newMap {
+				assert.Equal(t, value, patched[key])
+			}
+		})
+	}
+}
+
+func TestM_DiffFunc(t *testing.T) {
+	oldMap := Map[string, []int]{"a": {1}, "b": {2, 3}, "c": {4}}
+	newMap := Map[string, []int]{"a": {1}, "b": {2}, "d": {5}}
+	sameLength := func(oldValue, newValue []int) bool {
+		return len(oldValue) == len(newValue)
+	}
+
+	diff := oldMap.DiffFunc(newMap, sameLength)
+	assert.Equal(t, MapDiff[string, []int]{
+		Added:   Map[string, []int]{"d": {5}},
+		Removed: Map[string, []int]{"c": {4}},
+		Changed: map[string]ValueChange[[]int]{"b": {Old: []int{2, 3}, New: []int{2}}},
+	}, diff)
+	assert.Equal(t, diff, DiffFunc(oldMap, newMap, sameLength))
+}
+
+func TestM_Patch(t *testing.T) {
+	m := Map[string, int]{"a": 1, "b": 2}
+	diff := MapDiff[string, int]{
+		Added:   Map[string, int]{"c": 3},
+		Removed: Map[string, int]{"a": 1},
+		Changed: map[string]ValueChange[int]{"b": {Old: 2, New: 4}},
+	}
+
+	assert.Equal(t, Map[string, int]{"b": 4, "c": 3}, m.Patch(diff))
+	assert.Equal(t, Map[string, int]{"a": 1, "b": 2}, m)
+
+	assert.Equal(t, Map[string, int]{"b": 4, "c": 3}, m.PatchSelf(diff))
+	assert.Equal(t, Map[string, int]{"b": 4, "c": 3}, m)
+
+	assert.Equal(t, Map[string, int]{"c": 3, "b": 4}, Map[string, int](nil).Patch(diff))
+	assert.Equal(t, Map[string, int]{}, Map[string, int](nil).Patch(MapDiff[string, int]{}))
+}
@@ -0,0 +1,274 @@
+package maps
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock provides current time and timers, allows to control time in tests
+type Clock interface {
+	// Now returns current time
+	Now() time.Time
+	// After returns channel that receives current time once specified duration elapses
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock represents clock that returns system time
+type systemClock struct{}
+
+// Now returns current system time
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After returns channel that receives system time once specified duration elapses
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock is a clock that returns current system time
+var SystemClock Clock = systemClock{}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// expiringMapEntry represents value of expiring map with its expiration time, zero time means no expiration
+type expiringMapEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// expired returns true if entry is expired at specified time
+func (e expiringMapEntry[V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// ExpiringMap represents generic map safe for concurrent use which entries expire after their TTL, expired entries
+// are removed lazily on access, by DeleteExpired or by janitor, zero value is an empty map ready to use with no
+// default TTL and system clock
+type ExpiringMap[K comparable, V any] struct {
+	lock       sync.Mutex
+	data       map[K]expiringMapEntry[V]
+	defaultTTL time.Duration
+	clock      Clock
+	onExpire   func(key K, value V)
+}
+
+// NewExpiringMap creates new empty expiring map with specified default TTL, entries never expire by default if TTL
+// is not positive
+func NewExpiringMap[K comparable, V any](defaultTTL time.Duration) *ExpiringMap[K, V] {
+	return &ExpiringMap[K, V]{
+		data:       make(map[K]expiringMapEntry[V]),
+		defaultTTL: defaultTTL,
+		clock:      SystemClock,
+	}
+}
+
+// WithClock sets clock used to check expiration and to schedule janitor, should be called before map is used
+func (m *ExpiringMap[K, V]) WithClock(clock Clock) *ExpiringMap[K, V] {
+	m.clock = clock
+	return m
+}
+
+// WithOnExpire sets hook that is called with each expired entry when it's removed (including when it's overwritten or
+// deleted), hook is called after map is unlocked, so it can safely access map, should be called before map is used
+func (m *ExpiringMap[K, V]) WithOnExpire(onExpire func(key K, value V)) *ExpiringMap[K, V] {
+	m.onExpire = onExpire
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Set stores value by key with default TTL
+func (m *ExpiringMap[K, V]) Set(key K, value V) {
+	m.SetWithTTL(key, value, m.defaultTTL)
+}
+
+// SetWithTTL stores value by key with specified TTL, value never expires if TTL is not positive, OnExpire hook is
+// called if expired entry is overwritten
+func (m *ExpiringMap[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	entry := expiringMapEntry[V]{
+		value: value,
+	}
+
+	m.lock.Lock()
+	now := m.currentClock().Now()
+	if ttl > 0 {
+		entry.expiresAt = now.Add(ttl)
+	}
+
+	if m.data == nil {
+		m.data = make(map[K]expiringMapEntry[V])
+	}
+
+	previous, found := m.data[key]
+	m.data[key] = entry
+	m.lock.Unlock()
+
+	if found && previous.expired(now) {
+		m.notifyExpired([]Entry[K, V]{{Key: key, Value: previous.value}})
+	}
+}
+
+// Get returns value stored by key and true if key is present and not expired, expired entry is removed
+func (m *ExpiringMap[K, V]) Get(key K) (V, bool) {
+	m.lock.Lock()
+	entry, found := m.data[key]
+	if !found {
+		m.lock.Unlock()
+		var empty V
+		return empty, false
+	}
+
+	if !entry.expired(m.currentClock().Now()) {
+		m.lock.Unlock()
+		return entry.value, true
+	}
+
+	delete(m.data, key)
+	m.lock.Unlock()
+
+	m.notifyExpired([]Entry[K, V]{{Key: key, Value: entry.value}})
+	var empty V
+	return empty, false
+}
+
+// TTL returns remaining time to live of key and true if key is present and not expired, TTL is zero if key never
+// expires
+func (m *ExpiringMap[K, V]) TTL(key K) (time.Duration, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entry, found := m.data[key]
+	now := m.currentClock().Now()
+	if !found || entry.expired(now) {
+		return 0, false
+	}
+
+	if entry.expiresAt.IsZero() {
+		return 0, true
+	}
+	return entry.expiresAt.Sub(now), true
+}
+
+// Delete removes key from this map and returns true if key was present and not expired, OnExpire hook is called if
+// removed entry is expired
+func (m *ExpiringMap[K, V]) Delete(key K) bool {
+	m.lock.Lock()
+	entry, found := m.data[key]
+	if !found {
+		m.lock.Unlock()
+		return false
+	}
+
+	delete(m.data, key)
+	expired := entry.expired(m.currentClock().Now())
+	m.lock.Unlock()
+
+	if expired {
+		m.notifyExpired([]Entry[K, V]{{Key: key, Value: entry.value}})
+		return false
+	}
+	return true
+}
+
+// DeleteExpired removes all expired entries from this map calling OnExpire hook for each of them, returns number of
+// removed entries
+func (m *ExpiringMap[K, V]) DeleteExpired() int {
+	m.lock.Lock()
+	now := m.currentClock().Now()
+	var expired []Entry[K, V]
+	for key, entry := range m.data {
+		if entry.expired(now) {
+			delete(m.data, key)
+			expired = append(expired, Entry[K, V]{Key: key, Value: entry.value})
+		}
+	}
+	m.lock.Unlock()
+
+	m.notifyExpired(expired)
+	return len(expired)
+}
+
+// Len returns number of not expired entries in this map
+func (m *ExpiringMap[K, V]) Len() int {
+	count := 0
+	m.forEachAlive(func(_ K, _ V) {
+		count++
+	})
+	return count
+}
+
+// Keys returns keys of not expired entries of this map with no defined order
+func (m *ExpiringMap[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	m.forEachAlive(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Entries returns not expired entries of this map with no defined order
+func (m *ExpiringMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0)
+	m.forEachAlive(func(key K, value V) {
+		entries = append(entries, Entry[K, V]{
+			Key:   key,
+			Value: value,
+		})
+	})
+	return entries
+}
+
+// StartJanitor starts goroutine that calls DeleteExpired every interval (measured by clock) until context is done,
+// janitor is not started if interval is not positive
+func (m *ExpiringMap[K, V]) StartJanitor(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.currentClock().After(interval):
+				m.DeleteExpired()
+			}
+		}
+	}()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// forEachAlive calls provided action on each not expired entry of this map while map is locked
+func (m *ExpiringMap[K, V]) forEachAlive(action func(key K, value V)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := m.currentClock().Now()
+	for key, entry := range m.data {
+		if !entry.expired(now) {
+			action(key, entry.value)
+		}
+	}
+}
+
+// currentClock returns clock of this map, system clock if it's not set
+func (m *ExpiringMap[K, V]) currentClock() Clock {
+	if m.clock == nil {
+		return SystemClock
+	}
+	return m.clock
+}
+
+// notifyExpired calls OnExpire hook with each of expired entries if hook is set
+func (m *ExpiringMap[K, V]) notifyExpired(expired []Entry[K, V]) {
+	if m.onExpire == nil {
+		return
+	}
+
+	for _, entry := range expired {
+		m.onExpire(entry.Key, entry.Value)
+	}
+}
@@ -0,0 +1,245 @@
+package maps
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTimer struct {
+	deadline time.Time
+	fire     chan time.Time
+}
+
+type fakeClock struct {
+	lock    sync.Mutex
+	now     time.Time
+	timers  []fakeTimer
+	waiting chan struct{}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	fire := make(chan time.Time, 1)
+	c.timers = append(c.timers, fakeTimer{deadline: c.now.Add(d), fire: fire})
+	c.waiting <- struct{}{}
+	return fire
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.deadline.After(c.now) {
+			pending = append(pending, timer)
+			continue
+		}
+		timer.fire <- c.now
+	}
+	c.timers = pending
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		waiting: make(chan struct{}, 16),
+	}
+}
+
+func TestExpiringMap_Expiration(t *testing.T) {
+	clock := newFakeClock()
+	var expired []Entry[string, int]
+	m := NewExpiringMap[string, int](time.Minute).WithClock(clock).WithOnExpire(func(key string, value int) {
+		expired = append(expired, Entry[string, int]{Key: key, Value: value})
+	})
+
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, time.Second)
+	m.SetWithTTL("c", 3, 0)
+
+	value, found := m.Get("b")
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+
+	ttl, found := m.TTL("a")
+	assert.True(t, found)
+	assert.Equal(t, time.Minute, ttl)
+
+	ttl, found = m.TTL("c")
+	assert.True(t, found)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	clock.Advance(time.Second)
+	_, found = m.Get("b")
+	assert.False(t, found)
+	assert.Equal(t, []Entry[string, int]{{Key: "b", Value: 2}}, expired)
+
+	_, found = m.TTL("b")
+	assert.False(t, found)
+
+	assert.Equal(t, 2, m.Len())
+	assert.ElementsMatch(t, []string{"a", "c"}, m.Keys())
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, 1, m.Len())
+	assert.Equal(t, []Entry[string, int]{{Key: "c", Value: 3}}, m.Entries())
+
+	assert.Equal(t, 1, m.DeleteExpired())
+	assert.Equal(t, 0, m.DeleteExpired())
+	assert.Equal(t, []Entry[string, int]{{Key: "b", Value: 2}, {Key: "a", Value: 1}}, expired)
+
+	value, found = m.Get("c")
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+}
+
+func TestExpiringMap_SetResetsTTL(t *testing.T) {
+	clock := newFakeClock()
+	m := NewExpiringMap[string, int](time.Minute).WithClock(clock)
+
+	m.Set("a", 1)
+	clock.Advance(50 * time.Second)
+	m.Set("a", 2)
+	clock.Advance(50 * time.Second)
+
+	value, found := m.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+}
+
+func TestExpiringMap_Delete(t *testing.T) {
+	clock := newFakeClock()
+	var expired []Entry[string, int]
+	m := NewExpiringMap[string, int](time.Minute).WithClock(clock).WithOnExpire(func(key string, value int) {
+		expired = append(expired, Entry[string, int]{Key: key, Value: value})
+	})
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	assert.True(t, m.Delete("a"))
+	assert.False(t, m.Delete("a"))
+	assert.Empty(t, expired)
+
+	clock.Advance(time.Minute)
+	assert.False(t, m.Delete("b"))
+	assert.Equal(t, []Entry[string, int]{{Key: "b", Value: 2}}, expired)
+	assert.Equal(t, 0, m.DeleteExpired())
+}
+
+func TestExpiringMap_OverwriteExpired(t *testing.T) {
+	clock := newFakeClock()
+	var expired []Entry[string, int]
+	m := NewExpiringMap[string, int](time.Minute).WithClock(clock).WithOnExpire(func(key string, value int) {
+		expired = append(expired, Entry[string, int]{Key: key, Value: value})
+	})
+
+	m.Set("a", 1)
+	m.Set("a", 2)
+	assert.Empty(t, expired)
+
+	clock.Advance(time.Minute)
+	m.Set("a", 3)
+	assert.Equal(t, []Entry[string, int]{{Key: "a", Value: 2}}, expired)
+
+	value, found := m.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+}
+
+func TestExpiringMap_NoDefaultTTL(t *testing.T) {
+	clock := newFakeClock()
+	m := NewExpiringMap[string, int](0).WithClock(clock)
+
+	m.Set("a", 1)
+	clock.Advance(24 * time.Hour)
+
+	_, found := m.Get("a")
+	assert.True(t, found)
+}
+
+func TestExpiringMap_HookCanAccessMap(t *testing.T) {
+	clock := newFakeClock()
+	var m *ExpiringMap[string, int]
+	m = NewExpiringMap[string, int](time.Second).WithClock(clock).WithOnExpire(func(key string, value int) {
+		m.Set(key+"_expired", value)
+	})
+
+	m.Set("a", 1)
+	clock.Advance(time.Second)
+	m.Get("a")
+
+	value, found := m.Get("a_expired")
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+}
+
+func TestExpiringMap_ZeroValue(t *testing.T) {
+	var m ExpiringMap[string, int]
+
+	_, found := m.Get("a")
+	assert.False(t, found)
+	assert.False(t, m.Delete("a"))
+	assert.Equal(t, 0, m.DeleteExpired())
+	assert.Equal(t, 0, m.Len())
+
+	m.Set("a", 1)
+	value, found := m.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	ttl, found := m.TTL("a")
+	assert.True(t, found)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	m.SetWithTTL("b", 2, time.Hour)
+	assert.ElementsMatch(t, []string{"a", "b"}, m.Keys())
+}
+
+func TestExpiringMap_Janitor(t *testing.T) {
+	clock := newFakeClock()
+	expired := make(chan string, 1)
+	m := NewExpiringMap[string, int](time.Minute).WithClock(clock).WithOnExpire(func(key string, _ int) {
+		expired <- key
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.StartJanitor(ctx, 10*time.Second)
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	<-clock.waiting
+	clock.Advance(30 * time.Second)
+	m.SetWithTTL("b", 2, time.Hour)
+
+	<-clock.waiting
+	clock.Advance(30 * time.Second)
+	assert.Equal(t, "a", <-expired)
+
+	<-clock.waiting
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestExpiringMap_JanitorNotPositiveInterval(t *testing.T) {
+	clock := newFakeClock()
+	m := NewExpiringMap[string, int](time.Minute).WithClock(clock)
+
+	m.StartJanitor(context.Background(), 0)
+	m.StartJanitor(context.Background(), -time.Second)
+	assert.Empty(t, clock.waiting)
+}
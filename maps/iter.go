@@ -0,0 +1,207 @@
+//go:build go1.23
+
+package maps
+
+import "iter"
+
+// AllSeq returns iterator over key-value pairs of this map with no defined order
+//
+// Note: Iterators are named with Seq suffix, since All is already taken by predicate check of all entries
+func (m Map[K, V]) AllSeq() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range m {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// AllSeq returns iterator over key-value pairs of specified map with no defined order
+func AllSeq[K comparable, V any](m Map[K, V]) iter.Seq2[K, V] {
+	return m.AllSeq()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// KeysSeq returns iterator over keys of this map with no defined order
+func (m Map[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range m {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns iterator over keys of specified map with no defined order
+func KeysSeq[K comparable, V any](m Map[K, V]) iter.Seq[K] {
+	return m.KeysSeq()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// ValuesSeq returns iterator over values of this map with no defined order
+func (m Map[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range m {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns iterator over values of specified map with no defined order
+func ValuesSeq[K comparable, V any](m Map[K, V]) iter.Seq[V] {
+	return m.ValuesSeq()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Collect fills key-value pairs from iterator into this map
+func (m Map[K, V]) Collect(seq iter.Seq2[K, V]) Map[K, V] {
+	for key, value := range seq {
+		m[key] = value
+	}
+	return m
+}
+
+// Collect fills key-value pairs from iterator into specified map
+func Collect[K comparable, V any](m Map[K, V], seq iter.Seq2[K, V]) Map[K, V] {
+	return m.Collect(seq)
+}
+
+// FromSeq2 creates new map filled with key-value pairs from iterator
+func FromSeq2[K comparable, V any](seq iter.Seq2[K, V]) Map[K, V] {
+	return make(Map[K, V]).Collect(seq)
+}
+
+// FromSeq creates new map filled with entries from iterator
+func FromSeq[K comparable, V any](seq iter.Seq[Entry[K, V]]) Map[K, V] {
+	m := make(Map[K, V])
+	for entry := range seq {
+		m[entry.Key] = entry.Value
+	}
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// AllSeq returns iterator over key-value pairs of this map in insertion order
+func (m *OrderedMap[K, V]) AllSeq() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := m.first(); node != nil; node = node.next {
+			if !yield(node.key, node.value) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns iterator over keys of this map in insertion order
+func (m *OrderedMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for node := m.first(); node != nil; node = node.next {
+			if !yield(node.key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns iterator over values of this map in insertion order
+func (m *OrderedMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for node := m.first(); node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// Collect fills key-value pairs from iterator into this map in iteration order
+func (m *OrderedMap[K, V]) Collect(seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	for key, value := range seq {
+		m.Set(key, value)
+	}
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// AllSeq returns iterator over key-value pairs of this map in ascending order of keys
+func (m *SortedMap[K, V]) AllSeq() iter.Seq2[K, V] {
+	return m.Ascend
+}
+
+// BackwardSeq returns iterator over key-value pairs of this map in descending order of keys
+func (m *SortedMap[K, V]) BackwardSeq() iter.Seq2[K, V] {
+	return m.Descend
+}
+
+// KeysSeq returns iterator over keys of this map in ascending order
+func (m *SortedMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for node := m.first(); node != nil; node = node.next[0] {
+			if !yield(node.key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns iterator over values of this map in ascending order of their keys
+func (m *SortedMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for node := m.first(); node != nil; node = node.next[0] {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// Collect fills key-value pairs from iterator into this map
+func (m *SortedMap[K, V]) Collect(seq iter.Seq2[K, V]) *SortedMap[K, V] {
+	for key, value := range seq {
+		m.Set(key, value)
+	}
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// AllSeq returns iterator over key-value pairs of this map with no defined order, each shard is iterated over its
+// snapshot, so loop body can safely access this map
+func (m *ConcurrentMap[K, V]) AllSeq() iter.Seq2[K, V] {
+	return m.Range
+}
+
+// KeysSeq returns iterator over keys of this map with no defined order, each shard is iterated over its snapshot
+func (m *ConcurrentMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		m.Range(func(key K, _ V) bool {
+			return yield(key)
+		})
+	}
+}
+
+// ValuesSeq returns iterator over values of this map with no defined order, each shard is iterated over its snapshot
+func (m *ConcurrentMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		m.Range(func(_ K, value V) bool {
+			return yield(value)
+		})
+	}
+}
+
+// Collect stores key-value pairs from iterator into this map
+func (m *ConcurrentMap[K, V]) Collect(seq iter.Seq2[K, V]) *ConcurrentMap[K, V] {
+	for key, value := range seq {
+		m.Store(key, value)
+	}
+	return m
+}
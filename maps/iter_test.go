@@ -0,0 +1,126 @@
+//go:build go1.23
+
+package maps
+
+import (
+	stdmaps "maps"
+	stdslices "slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestM_AllSeq(t *testing.T) {
+	m := Map[string, int]{"a": 1, "b": 2, "c": 3}
+
+	assert.Equal(t, map[string]int(m), stdmaps.Collect(m.AllSeq()))
+	assert.Equal(t, map[string]int(m), stdmaps.Collect(AllSeq(m)))
+	assert.Equal(t, []string{"a", "b", "c"}, stdslices.Sorted(m.KeysSeq()))
+	assert.Equal(t, []string{"a", "b", "c"}, stdslices.Sorted(KeysSeq(m)))
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Sorted(m.ValuesSeq()))
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Sorted(ValuesSeq(m)))
+
+	count := 0
+	for range m.AllSeq() {
+		count++
+		break
+	}
+	for range m.KeysSeq() {
+		count++
+		break
+	}
+	for range m.ValuesSeq() {
+		count++
+		break
+	}
+	assert.Equal(t, 3, count)
+
+	var nilMap Map[string, int]
+	for range nilMap.AllSeq() {
+		t.Fail()
+	}
+}
+
+func TestM_Collect(t *testing.T) {
+	source := map[string]int{"a": 1, "b": 2}
+
+	assert.Equal(t, Map[string, int](source), FromSeq2(stdmaps.All(source)))
+	assert.Equal(t, Map[string, int]{"a": 1, "b": 2, "c": 3}, Map[string, int]{"c": 3}.Collect(stdmaps.All(source)))
+	assert.Equal(t, Map[string, int]{"a": 1, "b": 2}, Collect(Map[string, int]{"a": 0}, stdmaps.All(source)))
+	assert.Equal(t, Map[string, int]{"a": 1}, FromSeq(stdslices.Values([]Entry[string, int]{NewEntry("a", 1)})))
+}
+
+func TestOrderedMap_Seq(t *testing.T) {
+	m := OrderedFromEntries(orderedEntries)
+
+	var entries []Entry[string, int]
+	for key, value := range m.AllSeq() {
+		entries = append(entries, NewEntry(key, value))
+		if key == "d" {
+			break
+		}
+	}
+	assert.Equal(t, orderedEntries[:3], entries)
+
+	assert.Equal(t, m.Keys(), stdslices.Collect(m.KeysSeq()))
+	assert.Equal(t, m.Values(), stdslices.Collect(m.ValuesSeq()))
+	assert.Equal(t, []string{"c", "a"}, firstN(m.KeysSeq(), 2))
+	assert.Equal(t, []int{1}, firstN(m.ValuesSeq(), 1))
+
+	collected := NewOrderedMap[string, int]().Collect(m.AllSeq())
+	assert.Equal(t, orderedEntries, collected.Entries())
+}
+
+func TestSortedMap_Seq(t *testing.T) {
+	m := SortedFromMap(Map[int, string]{3: "c", 1: "a", 2: "b"})
+
+	var keys []int
+	for key := range m.AllSeq() {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []int{1, 2, 3}, keys)
+
+	keys = nil
+	for key := range m.BackwardSeq() {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []int{3, 2, 1}, keys)
+
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Collect(m.KeysSeq()))
+	assert.Equal(t, []string{"a", "b", "c"}, stdslices.Collect(m.ValuesSeq()))
+	assert.Equal(t, []int{1}, firstN(m.KeysSeq(), 1))
+	assert.Equal(t, []string{"a", "b"}, firstN(m.ValuesSeq(), 2))
+
+	collected := NewSortedMap[int, string]().Collect(m.BackwardSeq())
+	assert.Equal(t, m.Entries(), collected.Entries())
+}
+
+func TestConcurrentMap_Seq(t *testing.T) {
+	source := Map[int, int]{1: 10, 2: 20, 3: 30}
+	m := ConcurrentFromMap(source)
+
+	assert.Equal(t, map[int]int(source), stdmaps.Collect(m.AllSeq()))
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Sorted(m.KeysSeq()))
+	assert.Equal(t, []int{10, 20, 30}, stdslices.Sorted(m.ValuesSeq()))
+	assert.Len(t, firstN(m.KeysSeq(), 2), 2)
+	assert.Len(t, firstN(m.ValuesSeq(), 1), 1)
+
+	for key := range m.KeysSeq() {
+		m.Delete(key)
+	}
+	assert.Equal(t, 0, m.Len())
+
+	m.Collect(stdmaps.All(source))
+	assert.Equal(t, source, m.Map())
+}
+
+func firstN[T any](seq func(yield func(T) bool), n int) []T {
+	var values []T
+	for value := range seq {
+		values = append(values, value)
+		if len(values) == n {
+			break
+		}
+	}
+	return values
+}
@@ -3,6 +3,11 @@ Package maps provides useful generic types, methods & functions for maps.
 */
 package maps
 
+import (
+	"github.com/mymmrac/aki/option"
+	"github.com/mymmrac/aki/types"
+)
+
 // Values returns values of this map with no defined order
 func (m Map[K, V]) Values() []V {
 	values := make([]V, 0, len(m))
@@ -128,6 +133,156 @@ func FilterByValue[K comparable, V any](m Map[K, V], predicate PredicateByValue[
 
 // ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
 
+// FilterE returns new map from this, filtered by key and value using provided predicate, stops on the first predicate
+// error and returns it
+func (m Map[K, V]) FilterE(predicate PredicateE[K, V]) (Map[K, V], error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	filtered := make(Map[K, V])
+	for key, value := range m {
+		matched, err := predicate(key, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if matched {
+			filtered[key] = value
+		}
+	}
+	return filtered, nil
+}
+
+// FilterSelfE removes entries from this map that do not match provided predicate, stops on the first predicate
+// error and returns it leaving this map untouched
+func (m Map[K, V]) FilterSelfE(predicate PredicateE[K, V]) (Map[K, V], error) {
+	var unmatched []K
+	for key, value := range m {
+		matched, err := predicate(key, value)
+		if err != nil {
+			return m, err
+		}
+
+		if !matched {
+			unmatched = append(unmatched, key)
+		}
+	}
+
+	for _, key := range unmatched {
+		delete(m, key)
+	}
+	return m, nil
+}
+
+// FilterE returns new map from specified, filtered by key and value using provided predicate, stops on the first
+// predicate error and returns it
+func FilterE[K comparable, V any](m Map[K, V], predicate PredicateE[K, V]) (Map[K, V], error) {
+	return m.FilterE(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// FilterByKeyE returns new map from this, filtered by key using provided predicate, stops on the first predicate
+// error and returns it
+func (m Map[K, V]) FilterByKeyE(predicate PredicateByKeyE[K]) (Map[K, V], error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	filtered := make(Map[K, V])
+	for key, value := range m {
+		matched, err := predicate(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if matched {
+			filtered[key] = value
+		}
+	}
+	return filtered, nil
+}
+
+// FilterSelfByKeyE removes entries from this map which keys do not match provided predicate, stops on the first
+// predicate error and returns it leaving this map untouched
+func (m Map[K, V]) FilterSelfByKeyE(predicate PredicateByKeyE[K]) (Map[K, V], error) {
+	var unmatched []K
+	for key := range m {
+		matched, err := predicate(key)
+		if err != nil {
+			return m, err
+		}
+
+		if !matched {
+			unmatched = append(unmatched, key)
+		}
+	}
+
+	for _, key := range unmatched {
+		delete(m, key)
+	}
+	return m, nil
+}
+
+// FilterByKeyE returns new map from specified, filtered by key using provided predicate, stops on the first
+// predicate error and returns it
+func FilterByKeyE[K comparable, V any](m Map[K, V], predicate PredicateByKeyE[K]) (Map[K, V], error) {
+	return m.FilterByKeyE(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// FilterByValueE returns new map from this, filtered by value using provided predicate, stops on the first predicate
+// error and returns it
+func (m Map[K, V]) FilterByValueE(predicate PredicateByValueE[V]) (Map[K, V], error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	filtered := make(Map[K, V])
+	for key, value := range m {
+		matched, err := predicate(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if matched {
+			filtered[key] = value
+		}
+	}
+	return filtered, nil
+}
+
+// FilterSelfByValueE removes entries from this map which values do not match provided predicate, stops on the first
+// predicate error and returns it leaving this map untouched
+func (m Map[K, V]) FilterSelfByValueE(predicate PredicateByValueE[V]) (Map[K, V], error) {
+	var unmatched []K
+	for key, value := range m {
+		matched, err := predicate(value)
+		if err != nil {
+			return m, err
+		}
+
+		if !matched {
+			unmatched = append(unmatched, key)
+		}
+	}
+
+	for _, key := range unmatched {
+		delete(m, key)
+	}
+	return m, nil
+}
+
+// FilterByValueE returns new map from specified, filtered by value using provided predicate, stops on the first
+// predicate error and returns it
+func FilterByValueE[K comparable, V any](m Map[K, V], predicate PredicateByValueE[V]) (Map[K, V], error) {
+	return m.FilterByValueE(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
 // Entries returns entries of this map
 func (m Map[K, V]) Entries() []Entry[K, V] {
 	entries := make([]Entry[K, V], 0, len(m))
@@ -206,6 +361,29 @@ func Copy[K comparable, V any](m Map[K, V]) map[K]V {
 	return m.Copy()
 }
 
+// DeepCopyFunc returns deep copy of this map, values are copied using provided copier
+func (m Map[K, V]) DeepCopyFunc(copier func(value V) V) Map[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	copyMap := make(Map[K, V], len(m))
+	for key, value := range m {
+		copyMap[key] = copier(value)
+	}
+	return copyMap
+}
+
+// DeepCopyFunc returns deep copy of specified map, values are copied using provided copier
+func DeepCopyFunc[K comparable, V any](m Map[K, V], copier func(value V) V) Map[K, V] {
+	return m.DeepCopyFunc(copier)
+}
+
+// DeepCopy returns deep copy of specified map, values are copied using their Clone method
+func DeepCopy[K comparable, V types.Cloner[V]](m Map[K, V]) Map[K, V] {
+	return m.DeepCopyFunc(V.Clone)
+}
+
 // ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
 
 func (m Map[K, V]) Merge(other Map[K, V]) Map[K, V] {
@@ -260,6 +438,66 @@ func MergeLeft[K comparable, V any](this, other Map[K, V]) Map[K, V] {
 
 // ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
 
+// MergeWith returns new map with values from provided map merged into copy of this, values of keys present in both
+// maps are combined using provided resolver
+func (m Map[K, V]) MergeWith(other Map[K, V], resolver MergeResolver[K, V]) Map[K, V] {
+	merged := m.Copy()
+	for key, value := range other {
+		if left, found := m[key]; found {
+			value = resolver(key, left, value)
+		}
+
+		merged[key] = value
+	}
+	return merged
+}
+
+// MergeSelfWith merges values from provided map into this, values of keys present in both maps are combined using
+// provided resolver
+func (m Map[K, V]) MergeSelfWith(other Map[K, V], resolver MergeResolver[K, V]) Map[K, V] {
+	for key, value := range other {
+		if left, found := m[key]; found {
+			value = resolver(key, left, value)
+		}
+
+		m[key] = value
+	}
+	return m
+}
+
+// MergeWith merges values from provided map into specified, values of keys present in both maps are combined using
+// provided resolver
+func MergeWith[K comparable, V any](this, other Map[K, V], resolver MergeResolver[K, V]) Map[K, V] {
+	return this.MergeWith(other, resolver)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// MergeAll returns new map with values from provided maps merged into copy of this one by one, so the last map wins
+// on conflicts
+func (m Map[K, V]) MergeAll(others ...Map[K, V]) Map[K, V] {
+	merged := m.Copy()
+	for _, other := range others {
+		merged.MergeSelf(other)
+	}
+	return merged
+}
+
+// MergeSelfAll merges values from provided maps into this one by one, so the last map wins on conflicts
+func (m Map[K, V]) MergeSelfAll(others ...Map[K, V]) Map[K, V] {
+	for _, other := range others {
+		m.MergeSelf(other)
+	}
+	return m
+}
+
+// MergeAll returns new map with values of specified maps merged one by one, so the last map wins on conflicts
+func MergeAll[K comparable, V any](ms ...Map[K, V]) Map[K, V] {
+	return make(Map[K, V]).MergeSelfAll(ms...)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
 func (m Map[K, V]) ContainsKey(key K) bool {
 	_, found := m[key]
 	return found
@@ -268,3 +506,90 @@ func (m Map[K, V]) ContainsKey(key K) bool {
 func ContainsKey[K comparable, V any](m Map[K, V], key K) bool {
 	return m.ContainsKey(key)
 }
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// EqualFunc returns true if this and other maps contain the same keys with values equal by provided function, nil
+// and empty maps are equal
+func (m Map[K, V]) EqualFunc(other Map[K, V], equal func(value, otherValue V) bool) bool {
+	if len(m) != len(other) {
+		return false
+	}
+
+	for key, value := range m {
+		otherValue, found := other[key]
+		if !found || !equal(value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc returns true if specified maps contain the same keys with values equal by provided function, nil and
+// empty maps are equal
+func EqualFunc[K comparable, V any](this, other Map[K, V], equal func(value, otherValue V) bool) bool {
+	return this.EqualFunc(other, equal)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// GetOption returns value stored by key in this map as option, none if key is not present
+func (m Map[K, V]) GetOption(key K) option.Option[V] {
+	value, found := m[key]
+	return option.FromPair(value, found)
+}
+
+// GetOption returns value stored by key in specified map as option, none if key is not present
+func GetOption[K comparable, V any](m Map[K, V], key K) option.Option[V] {
+	return m.GetOption(key)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// MapValues returns new map from specified with the same keys and values converted using provided mapper
+func MapValues[K comparable, V, R any](m Map[K, V], mapper func(value V) R) Map[K, R] {
+	if m == nil {
+		return nil
+	}
+
+	mapped := make(Map[K, R], len(m))
+	for key, value := range m {
+		mapped[key] = mapper(value)
+	}
+	return mapped
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// MapKeys returns new map from specified with the same values and keys converted using provided mapper, if mapper
+// returns the same key for multiple entries, value of arbitrary one is kept
+func MapKeys[K comparable, V any, R comparable](m Map[K, V], mapper func(key K) R) Map[R, V] {
+	if m == nil {
+		return nil
+	}
+
+	mapped := make(Map[R, V], len(m))
+	for key, value := range m {
+		mapped[mapper(key)] = value
+	}
+	return mapped
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// MapEntries returns new map from entries of specified converted using provided mapper, if mapper returns the same
+// key for multiple entries, value of arbitrary one is kept
+func MapEntries[K comparable, V any, RK comparable, RV any](
+	m Map[K, V], mapper func(key K, value V) Entry[RK, RV],
+) Map[RK, RV] {
+	if m == nil {
+		return nil
+	}
+
+	mapped := make(Map[RK, RV], len(m))
+	for key, value := range m {
+		entry := mapper(key, value)
+		mapped[entry.Key] = entry.Value
+	}
+	return mapped
+}
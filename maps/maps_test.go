@@ -1,8 +1,12 @@
 package maps
 
 import (
+	"errors"
+	"reflect" //nolint:depguard // Used as baseline in benchmarks
+	"strconv"
 	"testing"
 
+	"github.com/mymmrac/aki/option"
 	"github.com/mymmrac/aki/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -210,6 +214,112 @@ func TestM_FilterSelfByValue(t *testing.T) {
 	}
 }
 
+var errPredicate = errors.New("predicate error")
+
+func TestM_FilterE(t *testing.T) {
+	for _, tt := range mapTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			predicate := func(key int, value float64) (bool, error) { return tt.filterPredicate(key, value), nil }
+			keyPredicate := func(key int) (bool, error) { return tt.filterKeyPredicate(key), nil }
+			valuePredicate := func(value float64) (bool, error) { return tt.filteredValuePredicate(value), nil }
+
+			filtered, err := tt.m.FilterE(predicate)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredMap, filtered)
+
+			filtered, err = FilterE(tt.m, predicate)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredMap, filtered)
+
+			filtered, err = tt.m.FilterByKeyE(keyPredicate)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredKeyMap, filtered)
+
+			filtered, err = FilterByKeyE(tt.m, keyPredicate)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredKeyMap, filtered)
+
+			filtered, err = tt.m.FilterByValueE(valuePredicate)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredValueMap, filtered)
+
+			filtered, err = FilterByValueE(tt.m, valuePredicate)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredValueMap, filtered)
+		})
+	}
+}
+
+func TestM_FilterSelfE(t *testing.T) {
+	for _, tt := range mapTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.m.Copy()
+			filtered, err := m.FilterSelfE(func(key int, value float64) (bool, error) {
+				return tt.filterPredicate(key, value), nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredMap, filtered)
+			assert.Equal(t, tt.filteredMap, m)
+
+			m = tt.m.Copy()
+			filtered, err = m.FilterSelfByKeyE(func(key int) (bool, error) { return tt.filterKeyPredicate(key), nil })
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredKeyMap, filtered)
+			assert.Equal(t, tt.filteredKeyMap, m)
+
+			m = tt.m.Copy()
+			filtered, err = m.FilterSelfByValueE(func(value float64) (bool, error) {
+				return tt.filteredValuePredicate(value), nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filteredValueMap, filtered)
+			assert.Equal(t, tt.filteredValueMap, m)
+		})
+	}
+}
+
+func TestM_FilterE_Error(t *testing.T) {
+	m := Map[int, float64]{1: 2, 3: 4, 5: 6}
+	original := m.Copy()
+
+	calls := 0
+	failing := func(key int, _ float64) (bool, error) {
+		calls++
+		if key == 3 {
+			return false, errPredicate
+		}
+		return key == 1, nil
+	}
+
+	filtered, err := m.FilterE(failing)
+	assert.ErrorIs(t, err, errPredicate)
+	assert.Nil(t, filtered)
+	assert.LessOrEqual(t, calls, len(m))
+
+	_, err = m.FilterByKeyE(func(key int) (bool, error) { return failing(key, 0) })
+	assert.ErrorIs(t, err, errPredicate)
+
+	_, err = m.FilterByValueE(func(value float64) (bool, error) { return false, errPredicate })
+	assert.ErrorIs(t, err, errPredicate)
+
+	selfFiltered, err := m.FilterSelfE(failing)
+	assert.ErrorIs(t, err, errPredicate)
+	assert.Equal(t, original, selfFiltered)
+	assert.Equal(t, original, m)
+
+	_, err = m.FilterSelfByKeyE(func(key int) (bool, error) { return failing(key, 0) })
+	assert.ErrorIs(t, err, errPredicate)
+	assert.Equal(t, original, m)
+
+	_, err = m.FilterSelfByValueE(func(value float64) (bool, error) { return value < 3, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, Map[int, float64]{1: 2}, m)
+
+	_, err = m.FilterSelfByValueE(func(_ float64) (bool, error) { return false, errPredicate })
+	assert.ErrorIs(t, err, errPredicate)
+	assert.Equal(t, Map[int, float64]{1: 2}, m)
+}
+
 func TestM_Entries(t *testing.T) {
 	for _, tt := range mapTestCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -334,12 +444,37 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestM_DeepCopyFunc(t *testing.T) {
+	assert.Nil(t, Map[string, []int](nil).DeepCopyFunc(nil))
+
+	m := Map[string, []int]{"a": {1}, "b": {2, 3}}
+	copyMap := DeepCopyFunc(m, func(value []int) []int {
+		return append([]int(nil), value...)
+	})
+	assert.Equal(t, m, copyMap)
+
+	copyMap["b"][0] = -1
+	assert.Equal(t, []int{2, 3}, m["b"])
+}
+
+func TestDeepCopy(t *testing.T) {
+	assert.Nil(t, DeepCopy[string, clonerSlice](nil))
+
+	m := Map[string, clonerSlice]{"a": {1}, "b": {2, 3}}
+	copyMap := DeepCopy(m)
+	assert.Equal(t, m, copyMap)
+
+	copyMap["b"][0] = -1
+	assert.Equal(t, clonerSlice{2, 3}, m["b"])
+}
+
 var twoMapsTestCases = []struct {
 	name      string
 	this      Map[int, float64]
 	other     Map[int, float64]
 	merge     Map[int, float64]
 	mergeLeft Map[int, float64]
+	mergeSum  Map[int, float64]
 }{
 	{
 		name:      "nil-nil",
@@ -347,6 +482,7 @@ var twoMapsTestCases = []struct {
 		other:     nil,
 		merge:     nil,
 		mergeLeft: nil,
+		mergeSum:  nil,
 	},
 	{
 		name:      "nil-empty",
@@ -354,6 +490,7 @@ var twoMapsTestCases = []struct {
 		other:     Map[int, float64]{},
 		merge:     nil,
 		mergeLeft: nil,
+		mergeSum:  nil,
 	},
 	{
 		name:      "empty-nil",
@@ -361,6 +498,7 @@ var twoMapsTestCases = []struct {
 		other:     nil,
 		merge:     Map[int, float64]{},
 		mergeLeft: Map[int, float64]{},
+		mergeSum:  Map[int, float64]{},
 	},
 	{
 		name:      "empty-empty",
@@ -368,6 +506,7 @@ var twoMapsTestCases = []struct {
 		other:     Map[int, float64]{},
 		merge:     Map[int, float64]{},
 		mergeLeft: Map[int, float64]{},
+		mergeSum:  Map[int, float64]{},
 	},
 	{
 		name:      "one-nil",
@@ -375,6 +514,7 @@ var twoMapsTestCases = []struct {
 		other:     nil,
 		merge:     Map[int, float64]{1: 2},
 		mergeLeft: Map[int, float64]{1: 2},
+		mergeSum:  Map[int, float64]{1: 2},
 	},
 	{
 		name:      "nil-one",
@@ -382,6 +522,7 @@ var twoMapsTestCases = []struct {
 		other:     Map[int, float64]{1: 2},
 		merge:     Map[int, float64]{1: 2},
 		mergeLeft: Map[int, float64]{1: 2},
+		mergeSum:  Map[int, float64]{1: 2},
 	},
 	{
 		name:      "same-same",
@@ -389,6 +530,7 @@ var twoMapsTestCases = []struct {
 		other:     Map[int, float64]{1: 2},
 		merge:     Map[int, float64]{1: 2},
 		mergeLeft: Map[int, float64]{1: 2},
+		mergeSum:  Map[int, float64]{1: 4},
 	},
 	{
 		name:      "diff",
@@ -396,6 +538,7 @@ var twoMapsTestCases = []struct {
 		other:     Map[int, float64]{3: 4},
 		merge:     Map[int, float64]{1: 2, 3: 4},
 		mergeLeft: Map[int, float64]{1: 2, 3: 4},
+		mergeSum:  Map[int, float64]{1: 2, 3: 4},
 	},
 	{
 		name:      "diff-with-same",
@@ -403,6 +546,7 @@ var twoMapsTestCases = []struct {
 		other:     Map[int, float64]{1: 5, 3: 4},
 		merge:     Map[int, float64]{1: 5, 3: 4},
 		mergeLeft: Map[int, float64]{1: 2, 3: 4},
+		mergeSum:  Map[int, float64]{1: 7, 3: 4},
 	},
 }
 
@@ -421,6 +565,142 @@ func TestM_Merge(t *testing.T) {
 	}
 }
 
+func TestM_EqualFunc(t *testing.T) {
+	for _, tt := range equalTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			toSlice := func(value int) []int { return []int{value} }
+			this := MapValues(Map[string, int](tt.this), toSlice)
+			other := MapValues(Map[string, int](tt.other), toSlice)
+
+			assert.Equal(t, tt.equal, this.EqualFunc(other, equalSlices))
+			assert.Equal(t, tt.equal, EqualFunc(other, this, equalSlices))
+		})
+	}
+
+	t.Run("early_exit", func(t *testing.T) {
+		equal := EqualFunc(Map[string, int]{"a": 1}, Map[string, int]{}, func(_, _ int) bool {
+			assert.Fail(t, "must not be called")
+			return true
+		})
+		assert.False(t, equal)
+	})
+}
+
+func equalSlices(value, otherValue []int) bool {
+	if len(value) != len(otherValue) {
+		return false
+	}
+
+	for i := range value {
+		if value[i] != otherValue[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkM_EqualFunc(b *testing.B) {
+	this, other := benchmarkSliceMaps(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !this.EqualFunc(other, equalSlices) {
+			b.Fatal("maps must be equal")
+		}
+	}
+}
+
+func BenchmarkM_EqualFunc_ReflectDeepEqual(b *testing.B) {
+	this, other := benchmarkSliceMaps(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !reflect.DeepEqual(this, other) {
+			b.Fatal("maps must be equal")
+		}
+	}
+}
+
+func benchmarkSliceMaps(size int) (Map[string, []int], Map[string, []int]) {
+	this := make(Map[string, []int], size)
+	other := make(Map[string, []int], size)
+	for i := 0; i < size; i++ {
+		this[strconv.Itoa(i)] = []int{i, i + 1}
+		other[strconv.Itoa(i)] = []int{i, i + 1}
+	}
+	return this, other
+}
+
+func TestM_GetOption(t *testing.T) {
+	m := Map[string, int]{"a": 0, "b": 2}
+	assert.Equal(t, option.Some(0), m.GetOption("a"))
+	assert.Equal(t, option.Some(2), GetOption(m, "b"))
+	assert.Equal(t, option.None[int](), m.GetOption("c"))
+	assert.Equal(t, option.None[int](), Map[string, int](nil).GetOption("a"))
+}
+
+func TestMapValues(t *testing.T) {
+	assert.Nil(t, MapValues(Map[int, int](nil), strconv.Itoa))
+	assert.Equal(t, Map[int, string]{}, MapValues(Map[int, int]{}, strconv.Itoa))
+	assert.Equal(t, Map[int, string]{1: "2", 3: "4"}, MapValues(Map[int, int]{1: 2, 3: 4}, strconv.Itoa))
+}
+
+func TestMapKeys(t *testing.T) {
+	assert.Nil(t, MapKeys(Map[int, int](nil), strconv.Itoa))
+	assert.Equal(t, Map[string, int]{}, MapKeys(Map[int, int]{}, strconv.Itoa))
+	assert.Equal(t, Map[string, int]{"1": 2, "3": 4}, MapKeys(Map[int, int]{1: 2, 3: 4}, strconv.Itoa))
+
+	collided := MapKeys(Map[int, int]{1: 2, 3: 4}, func(_ int) bool { return true })
+	assert.Len(t, collided, 1)
+}
+
+func TestMapEntries(t *testing.T) {
+	swap := func(key, value int) Entry[string, int] {
+		return NewEntry(strconv.Itoa(value), key)
+	}
+
+	assert.Nil(t, MapEntries(Map[int, int](nil), swap))
+	assert.Equal(t, Map[string, int]{}, MapEntries(Map[int, int]{}, swap))
+	assert.Equal(t, Map[string, int]{"2": 1, "4": 3}, MapEntries(Map[int, int]{1: 2, 3: 4}, swap))
+}
+
+func TestM_MergeWith(t *testing.T) {
+	sum := func(_ int, left, right float64) float64 { return left + right }
+
+	for _, tt := range twoMapsTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.this == nil && len(tt.other) != 0 {
+				assert.Panics(t, func() {
+					tt.this.MergeWith(tt.other, sum)
+				})
+				return
+			}
+
+			this := tt.this.Copy()
+			assert.Equal(t, tt.mergeSum, tt.this.MergeWith(tt.other, sum))
+			assert.Equal(t, tt.mergeSum, MergeWith(tt.this, tt.other, sum))
+			assert.Equal(t, this, tt.this)
+
+			assert.Equal(t, tt.mergeSum, this.MergeSelfWith(tt.other, sum))
+			assert.Equal(t, tt.mergeSum, this)
+		})
+	}
+}
+
+func TestM_MergeAll(t *testing.T) {
+	first := Map[int, float64]{1: 1, 2: 2}
+	second := Map[int, float64]{2: 20, 3: 30}
+	third := Map[int, float64]{3: 300}
+	expected := Map[int, float64]{1: 1, 2: 20, 3: 300}
+
+	assert.Equal(t, expected, first.MergeAll(second, nil, third))
+	assert.Equal(t, Map[int, float64]{1: 1, 2: 2}, first)
+	assert.Equal(t, expected, MergeAll(first, second, third))
+	assert.Equal(t, Map[int, float64]{}, MergeAll[int, float64]())
+	assert.Equal(t, first, first.MergeAll())
+
+	assert.Equal(t, expected, first.MergeSelfAll(second, third))
+	assert.Equal(t, expected, first)
+}
+
 func TestNewEntry(t *testing.T) {
 	e1 := Entry[int, float64]{
 		Key:   1,
@@ -454,3 +734,52 @@ func TestToMCloneable(t *testing.T) {
 		assert.Equal(t, m4, ToCloneableMap[int, cloneableFloat](m3))
 	})
 }
+
+type clonerSlice []int
+
+func (c clonerSlice) Clone() clonerSlice {
+	return append(clonerSlice(nil), c...)
+}
+
+func TestCloneableM_EqualFunc(t *testing.T) {
+	equal := func(value, otherValue cloneableFloat) bool {
+		return value == otherValue
+	}
+
+	this := CloneableMap[int, cloneableFloat, cloneableFloat]{1: 2, 3: 4}
+	assert.True(t, this.EqualFunc(this.Clone(), equal))
+	assert.True(t, EqualCloneable(this, CloneableMap[int, cloneableFloat, cloneableFloat]{3: 4, 1: 2}, equal))
+	assert.False(t, EqualCloneable(this, CloneableMap[int, cloneableFloat, cloneableFloat]{1: 2, 3: 5}, equal))
+	assert.False(t, EqualCloneable(this, nil, equal))
+	assert.True(t, EqualCloneable(nil, CloneableMap[int, cloneableFloat, cloneableFloat]{}, equal))
+}
+
+func TestClonerM_Clone(t *testing.T) {
+	assert.Nil(t, ClonerMap[string, clonerSlice](nil).Clone())
+	assert.Nil(t, CloneMapValues[string, clonerSlice](nil))
+
+	m := ToClonerMap(map[string]clonerSlice{"a": {1}, "b": {2, 3}})
+	cloned := m.Clone()
+	assert.Equal(t, m, cloned)
+
+	cloned["a"][0] = -1
+	assert.Equal(t, clonerSlice{1}, m["a"])
+
+	values := CloneMapValues(m)
+	assert.Equal(t, Map[string, clonerSlice](m), values)
+	values["b"][0] = -1
+	assert.Equal(t, clonerSlice{2, 3}, m["b"])
+}
+
+func TestClonerM_EqualFunc(t *testing.T) {
+	equal := func(value, otherValue clonerSlice) bool {
+		return equalSlices(value, otherValue)
+	}
+
+	this := ClonerMap[string, clonerSlice]{"a": {1}, "b": {2, 3}}
+	assert.True(t, this.EqualFunc(this.Clone(), equal))
+	assert.True(t, EqualCloner(this, ClonerMap[string, clonerSlice]{"b": {2, 3}, "a": {1}}, equal))
+	assert.False(t, EqualCloner(this, ClonerMap[string, clonerSlice]{"a": {1}, "b": {2}}, equal))
+	assert.False(t, EqualCloner(this, nil, equal))
+	assert.True(t, EqualCloner(nil, ClonerMap[string, clonerSlice]{}, equal))
+}
@@ -0,0 +1,219 @@
+package maps
+
+import "github.com/mymmrac/aki/sets"
+
+// Put adds value to values of key in this map
+func (m MultiMap[K, V]) Put(key K, value V) {
+	m[key] = append(m[key], value)
+}
+
+// PutAll adds values to values of key in this map
+func (m MultiMap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	m[key] = append(m[key], values...)
+}
+
+// Get returns values of key in this map in insertion order, nil if key is not present
+func (m MultiMap[K, V]) Get(key K) []V {
+	return m[key]
+}
+
+// Remove removes all occurrences of value from values of key in this map, key is removed if it has no values left,
+// returns true if any value was removed
+//
+// Note: Values are copied into new slice, so slices previously returned by Get are not modified
+func (m MultiMap[K, V]) Remove(key K, value V) bool {
+	if !m.ContainsEntry(key, value) {
+		return false
+	}
+
+	values := m[key]
+	kept := make([]V, 0, len(values)-1)
+	for _, v := range values {
+		if v != value {
+			kept = append(kept, v)
+		}
+	}
+
+	if len(kept) == 0 {
+		delete(m, key)
+	} else {
+		m[key] = kept
+	}
+	return true
+}
+
+// RemoveAll removes key with all its values from this map, returns removed values
+func (m MultiMap[K, V]) RemoveAll(key K) []V {
+	values := m[key]
+	delete(m, key)
+	return values
+}
+
+// ContainsKey returns true if key has at least one value in this map
+func (m MultiMap[K, V]) ContainsKey(key K) bool {
+	return len(m[key]) > 0
+}
+
+// ContainsEntry returns true if value is one of values of key in this map
+func (m MultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	for _, v := range m[key] {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyCount returns number of keys in this map
+func (m MultiMap[K, V]) KeyCount() int {
+	return len(m)
+}
+
+// ValueCount returns number of values of all keys in this map
+func (m MultiMap[K, V]) ValueCount() int {
+	count := 0
+	for _, values := range m {
+		count += len(values)
+	}
+	return count
+}
+
+// Entries returns all key-value pairs of this map, keys have no defined order, values of each key are in insertion
+// order
+func (m MultiMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.ValueCount())
+	for key, values := range m {
+		for _, value := range values {
+			entries = append(entries, Entry[K, V]{
+				Key:   key,
+				Value: value,
+			})
+		}
+	}
+	return entries
+}
+
+// Copy returns copy of this map, slices of values are copied too
+func (m MultiMap[K, V]) Copy() MultiMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	copyMap := make(MultiMap[K, V], len(m))
+	for key, values := range m {
+		copyMap[key] = append([]V(nil), values...)
+	}
+	return copyMap
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Put adds value to values of key in this map, returns true if value was not present before
+func (m SetMultiMap[K, V]) Put(key K, value V) bool {
+	values, found := m[key]
+	if !found {
+		m[key] = sets.New(value)
+		return true
+	}
+
+	if values.Contains(value) {
+		return false
+	}
+
+	values.Add(value)
+	return true
+}
+
+// PutAll adds values to values of key in this map
+func (m SetMultiMap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+
+	if existing, found := m[key]; found {
+		existing.Add(values...)
+		return
+	}
+	m[key] = sets.New(values...)
+}
+
+// Get returns values of key in this map, nil if key is not present
+func (m SetMultiMap[K, V]) Get(key K) sets.Set[V] {
+	return m[key]
+}
+
+// Remove removes value from values of key in this map, key is removed if it has no values left, returns true if
+// value was removed
+func (m SetMultiMap[K, V]) Remove(key K, value V) bool {
+	values, found := m[key]
+	if !found || !values.Contains(value) {
+		return false
+	}
+
+	values.Remove(value)
+	if values.Len() == 0 {
+		delete(m, key)
+	}
+	return true
+}
+
+// RemoveAll removes key with all its values from this map, returns removed values
+func (m SetMultiMap[K, V]) RemoveAll(key K) sets.Set[V] {
+	values := m[key]
+	delete(m, key)
+	return values
+}
+
+// ContainsKey returns true if key has at least one value in this map
+func (m SetMultiMap[K, V]) ContainsKey(key K) bool {
+	return m[key].Len() > 0
+}
+
+// ContainsEntry returns true if value is one of values of key in this map
+func (m SetMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	return m[key].Contains(value)
+}
+
+// KeyCount returns number of keys in this map
+func (m SetMultiMap[K, V]) KeyCount() int {
+	return len(m)
+}
+
+// ValueCount returns number of values of all keys in this map
+func (m SetMultiMap[K, V]) ValueCount() int {
+	count := 0
+	for _, values := range m {
+		count += values.Len()
+	}
+	return count
+}
+
+// Entries returns all key-value pairs of this map with no defined order
+func (m SetMultiMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.ValueCount())
+	for key, values := range m {
+		for value := range values {
+			entries = append(entries, Entry[K, V]{
+				Key:   key,
+				Value: value,
+			})
+		}
+	}
+	return entries
+}
+
+// Copy returns copy of this map, sets of values are copied too
+func (m SetMultiMap[K, V]) Copy() SetMultiMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	copyMap := make(SetMultiMap[K, V], len(m))
+	for key, values := range m {
+		copyMap[key] = values.Copy()
+	}
+	return copyMap
+}
@@ -0,0 +1,138 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mymmrac/aki/sets"
+)
+
+func TestMultiM_PutGet(t *testing.T) {
+	m := make(MultiMap[string, int])
+	m.Put("a", 1)
+	m.Put("a", 2)
+	m.Put("a", 1)
+	m.PutAll("b", 3, 4)
+	m.PutAll("c")
+
+	assert.Equal(t, []int{1, 2, 1}, m.Get("a"))
+	assert.Equal(t, []int{3, 4}, m.Get("b"))
+	assert.Nil(t, m.Get("c"))
+	assert.False(t, m.ContainsKey("c"))
+	assert.True(t, m.ContainsKey("a"))
+
+	assert.Equal(t, 2, m.KeyCount())
+	assert.Equal(t, 5, m.ValueCount())
+
+	assert.True(t, m.ContainsEntry("a", 2))
+	assert.False(t, m.ContainsEntry("a", 3))
+	assert.False(t, m.ContainsEntry("c", 1))
+}
+
+func TestMultiM_Remove(t *testing.T) {
+	m := ToMultiMap(map[string][]int{"a": {1, 2, 1}, "b": {3}})
+
+	assert.False(t, m.Remove("a", 3))
+	assert.False(t, m.Remove("c", 1))
+
+	assert.True(t, m.Remove("a", 1))
+	assert.Equal(t, []int{2}, m.Get("a"))
+
+	assert.True(t, m.Remove("b", 3))
+	assert.False(t, m.ContainsKey("b"))
+	assert.Equal(t, 1, m.KeyCount())
+
+	assert.Equal(t, []int{2}, m.RemoveAll("a"))
+	assert.Nil(t, m.RemoveAll("a"))
+	assert.Empty(t, m)
+}
+
+func TestMultiM_RemoveKeepsGetResult(t *testing.T) {
+	m := MultiMap[string, int]{}
+	m.PutAll("a", 1, 2, 3)
+
+	values := m.Get("a")
+	assert.True(t, m.Remove("a", 1))
+	assert.Equal(t, []int{1, 2, 3}, values)
+	assert.Equal(t, []int{2, 3}, m.Get("a"))
+}
+
+func TestMultiM_Entries(t *testing.T) {
+	assert.Empty(t, MultiMap[string, int](nil).Entries())
+
+	m := MultiMap[string, int]{"a": {1, 2}, "b": {3}}
+	assert.ElementsMatch(t, []Entry[string, int]{
+		{Key: "a", Value: 1},
+		{Key: "a", Value: 2},
+		{Key: "b", Value: 3},
+	}, m.Entries())
+}
+
+func TestMultiM_Copy(t *testing.T) {
+	assert.Nil(t, MultiMap[string, int](nil).Copy())
+
+	m := MultiMap[string, int]{"a": {1, 2}}
+	copyMap := m.Copy()
+	assert.Equal(t, m, copyMap)
+
+	copyMap.Put("a", 3)
+	copyMap.Get("a")[0] = -1
+	assert.Equal(t, []int{1, 2}, m.Get("a"))
+}
+
+func TestSetMultiM_PutGet(t *testing.T) {
+	m := make(SetMultiMap[string, int])
+	assert.True(t, m.Put("a", 1))
+	assert.True(t, m.Put("a", 2))
+	assert.False(t, m.Put("a", 1))
+	m.PutAll("b", 3, 4, 3)
+	m.PutAll("b", 5)
+	m.PutAll("c")
+
+	assert.Equal(t, sets.New(1, 2), m.Get("a"))
+	assert.Equal(t, sets.New(3, 4, 5), m.Get("b"))
+	assert.Nil(t, m.Get("c"))
+	assert.False(t, m.ContainsKey("c"))
+	assert.True(t, m.ContainsKey("a"))
+
+	assert.Equal(t, 2, m.KeyCount())
+	assert.Equal(t, 5, m.ValueCount())
+
+	assert.True(t, m.ContainsEntry("a", 2))
+	assert.False(t, m.ContainsEntry("a", 3))
+	assert.False(t, m.ContainsEntry("c", 1))
+}
+
+func TestSetMultiM_Remove(t *testing.T) {
+	m := ToSetMultiMap(map[string]sets.Set[int]{"a": sets.New(1, 2), "b": sets.New(3)})
+
+	assert.False(t, m.Remove("a", 3))
+	assert.False(t, m.Remove("c", 1))
+
+	assert.True(t, m.Remove("a", 1))
+	assert.Equal(t, sets.New(2), m.Get("a"))
+
+	assert.True(t, m.Remove("b", 3))
+	assert.False(t, m.ContainsKey("b"))
+
+	assert.Equal(t, sets.New(2), m.RemoveAll("a"))
+	assert.Nil(t, m.RemoveAll("a"))
+	assert.Empty(t, m)
+}
+
+func TestSetMultiM_EntriesCopy(t *testing.T) {
+	m := SetMultiMap[string, int]{"a": sets.New(1, 2), "b": sets.New(3)}
+	assert.ElementsMatch(t, []Entry[string, int]{
+		{Key: "a", Value: 1},
+		{Key: "a", Value: 2},
+		{Key: "b", Value: 3},
+	}, m.Entries())
+
+	assert.Nil(t, SetMultiMap[string, int](nil).Copy())
+
+	copyMap := m.Copy()
+	assert.Equal(t, m, copyMap)
+	copyMap.Put("a", 3)
+	assert.False(t, m.ContainsEntry("a", 3))
+}
@@ -0,0 +1,299 @@
+package maps
+
+// orderedMapNode represents node of ordered map insertion list
+type orderedMapNode[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedMapNode[K, V]
+	next  *orderedMapNode[K, V]
+}
+
+// OrderedMap represents generic map that remembers insertion order of its keys, zero value is an empty map ready to
+// use
+type OrderedMap[K comparable, V any] struct {
+	nodes map[K]*orderedMapNode[K, V]
+	head  *orderedMapNode[K, V]
+	tail  *orderedMapNode[K, V]
+}
+
+// NewOrderedMap creates new empty ordered map
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+// OrderedFromEntries creates new ordered map filled with entries in the same order
+func OrderedFromEntries[K comparable, V any](entries []Entry[K, V]) *OrderedMap[K, V] {
+	return NewOrderedMap[K, V]().FillEntries(entries)
+}
+
+// OrderedFromEntry creates new ordered map filled with entries in the same order
+func OrderedFromEntry[K comparable, V any](entries ...Entry[K, V]) *OrderedMap[K, V] {
+	return OrderedFromEntries(entries)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Len returns number of entries in this map
+func (m *OrderedMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.nodes)
+}
+
+// Get returns value stored by key and true if key is present
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if m == nil {
+		var empty V
+		return empty, false
+	}
+
+	node, found := m.nodes[key]
+	if !found {
+		var empty V
+		return empty, false
+	}
+	return node.value, true
+}
+
+// Set stores value by key, new keys are added to the end, existing keys keep their position
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if node, found := m.nodes[key]; found {
+		node.value = value
+		return
+	}
+
+	if m.nodes == nil {
+		m.nodes = make(map[K]*orderedMapNode[K, V])
+	}
+
+	node := &orderedMapNode[K, V]{
+		key:   key,
+		value: value,
+		prev:  m.tail,
+	}
+
+	if m.tail == nil {
+		m.head = node
+	} else {
+		m.tail.next = node
+	}
+	m.tail = node
+
+	m.nodes[key] = node
+}
+
+// Delete removes key from this map and returns true if key was present
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	if m == nil {
+		return false
+	}
+
+	node, found := m.nodes[key]
+	if !found {
+		return false
+	}
+
+	if node.prev == nil {
+		m.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+
+	if node.next == nil {
+		m.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+
+	delete(m.nodes, key)
+	return true
+}
+
+// ContainsKey returns true if key is present in this map
+func (m *OrderedMap[K, V]) ContainsKey(key K) bool {
+	if m == nil {
+		return false
+	}
+
+	_, found := m.nodes[key]
+	return found
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Keys returns keys of this map in insertion order
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for node := m.first(); node != nil; node = node.next {
+		keys = append(keys, node.key)
+	}
+	return keys
+}
+
+// Values returns values of this map in insertion order
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for node := m.first(); node != nil; node = node.next {
+		values = append(values, node.value)
+	}
+	return values
+}
+
+// Entries returns entries of this map in insertion order
+func (m *OrderedMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	for node := m.first(); node != nil; node = node.next {
+		entries = append(entries, Entry[K, V]{
+			Key:   node.key,
+			Value: node.value,
+		})
+	}
+	return entries
+}
+
+// FillEntries fills entries into this map in the same order
+func (m *OrderedMap[K, V]) FillEntries(entries []Entry[K, V]) *OrderedMap[K, V] {
+	for _, entry := range entries {
+		m.Set(entry.Key, entry.Value)
+	}
+	return m
+}
+
+// FillEntry fills entries into this map in the same order
+func (m *OrderedMap[K, V]) FillEntry(entries ...Entry[K, V]) *OrderedMap[K, V] {
+	return m.FillEntries(entries)
+}
+
+// Map returns regular map with entries of this map
+func (m *OrderedMap[K, V]) Map() Map[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	result := make(Map[K, V], len(m.nodes))
+	for key, node := range m.nodes {
+		result[key] = node.value
+	}
+	return result
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Filter returns new map from this, filtered by key and value using provided predicate, order is preserved
+func (m *OrderedMap[K, V]) Filter(predicate Predicate[K, V]) *OrderedMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	filtered := NewOrderedMap[K, V]()
+	for node := m.head; node != nil; node = node.next {
+		if predicate(node.key, node.value) {
+			filtered.Set(node.key, node.value)
+		}
+	}
+	return filtered
+}
+
+// FilterSelf removes entries from this map that do not match provided predicate
+func (m *OrderedMap[K, V]) FilterSelf(predicate Predicate[K, V]) *OrderedMap[K, V] {
+	for node := m.first(); node != nil; node = node.next {
+		if !predicate(node.key, node.value) {
+			m.Delete(node.key)
+		}
+	}
+	return m
+}
+
+// FilterByKey returns new map from this, filtered by key using provided predicate, order is preserved
+func (m *OrderedMap[K, V]) FilterByKey(predicate PredicateByKey[K]) *OrderedMap[K, V] {
+	return m.Filter(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterSelfByKey removes entries from this map which keys do not match provided predicate
+func (m *OrderedMap[K, V]) FilterSelfByKey(predicate PredicateByKey[K]) *OrderedMap[K, V] {
+	return m.FilterSelf(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterByValue returns new map from this, filtered by value using provided predicate, order is preserved
+func (m *OrderedMap[K, V]) FilterByValue(predicate PredicateByValue[V]) *OrderedMap[K, V] {
+	return m.Filter(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// FilterSelfByValue removes entries from this map which values do not match provided predicate
+func (m *OrderedMap[K, V]) FilterSelfByValue(predicate PredicateByValue[V]) *OrderedMap[K, V] {
+	return m.FilterSelf(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Copy returns shallow copy of this map, order is preserved
+func (m *OrderedMap[K, V]) Copy() *OrderedMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	copyMap := NewOrderedMap[K, V]()
+	for node := m.head; node != nil; node = node.next {
+		copyMap.Set(node.key, node.value)
+	}
+	return copyMap
+}
+
+// Merge returns new map with values from provided map merged into copy of this, new keys are added in order of
+// provided map
+func (m *OrderedMap[K, V]) Merge(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	merged := m.Copy()
+	if merged == nil {
+		merged = NewOrderedMap[K, V]()
+	}
+	return merged.MergeSelf(other)
+}
+
+// MergeSelf merges values from provided map into this
+func (m *OrderedMap[K, V]) MergeSelf(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	for node := other.first(); node != nil; node = node.next {
+		m.Set(node.key, node.value)
+	}
+	return m
+}
+
+// MergeLeft returns new map with values from provided map merged into copy of this, keeping values of this on
+// conflicts
+func (m *OrderedMap[K, V]) MergeLeft(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	merged := m.Copy()
+	if merged == nil {
+		merged = NewOrderedMap[K, V]()
+	}
+	return merged.MergeSelfLeft(other)
+}
+
+// MergeSelfLeft merges values from provided map into this, keeping values of this on conflicts
+func (m *OrderedMap[K, V]) MergeSelfLeft(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	for node := other.first(); node != nil; node = node.next {
+		if m.ContainsKey(node.key) {
+			continue
+		}
+
+		m.Set(node.key, node.value)
+	}
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// first returns first node of this map or nil if map is nil or empty
+func (m *OrderedMap[K, V]) first() *orderedMapNode[K, V] {
+	if m == nil {
+		return nil
+	}
+	return m.head
+}
@@ -0,0 +1,126 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var orderedEntries = []Entry[string, int]{
+	{Key: "c", Value: 1},
+	{Key: "a", Value: 2},
+	{Key: "d", Value: 3},
+	{Key: "b", Value: 4},
+}
+
+func TestOrderedMap_SetGetDelete(t *testing.T) {
+	var m OrderedMap[string, int]
+	assert.Equal(t, 0, m.Len())
+
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+
+	value, found := m.Get("b")
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+	assert.Equal(t, []string{"b", "a"}, m.Keys())
+
+	_, found = m.Get("c")
+	assert.False(t, found)
+
+	assert.True(t, m.Delete("b"))
+	assert.False(t, m.Delete("b"))
+	assert.False(t, m.ContainsKey("b"))
+	assert.True(t, m.ContainsKey("a"))
+
+	m.Set("b", 4)
+	assert.Equal(t, []Entry[string, int]{{Key: "a", Value: 2}, {Key: "b", Value: 4}}, m.Entries())
+}
+
+func TestOrderedMap_Nil(t *testing.T) {
+	var m *OrderedMap[string, int]
+
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.ContainsKey("a"))
+	assert.False(t, m.Delete("a"))
+	assert.Equal(t, []string{}, m.Keys())
+	assert.Equal(t, []Entry[string, int]{}, m.Entries())
+	assert.Nil(t, m.Copy())
+	assert.Nil(t, m.Map())
+	assert.Nil(t, m.Filter(func(_ string, _ int) bool { return true }))
+
+	_, found := m.Get("a")
+	assert.False(t, found)
+}
+
+func TestOrderedMap_Order(t *testing.T) {
+	m := OrderedFromEntries(orderedEntries)
+
+	assert.Equal(t, orderedEntries, m.Entries())
+	assert.Equal(t, []string{"c", "a", "d", "b"}, m.Keys())
+	assert.Equal(t, []int{1, 2, 3, 4}, m.Values())
+	assert.Equal(t, Map[string, int]{"a": 2, "b": 4, "c": 1, "d": 3}, m.Map())
+	assert.Equal(t, orderedEntries, OrderedFromEntry(orderedEntries...).Entries())
+}
+
+func TestOrderedMap_Filter(t *testing.T) {
+	m := OrderedFromEntries(orderedEntries)
+
+	filtered := m.Filter(func(key string, value int) bool { return key == "a" || value == 4 })
+	assert.Equal(t, []string{"a", "b"}, filtered.Keys())
+	assert.Equal(t, 4, m.Len())
+
+	assert.Equal(t, []string{"d"}, m.FilterByKey(func(key string) bool { return key == "d" }).Keys())
+	assert.Equal(t, []string{"c", "d"}, m.FilterByValue(func(value int) bool { return value%2 == 1 }).Keys())
+
+	m.FilterSelf(func(key string, _ int) bool { return key != "c" })
+	assert.Equal(t, []string{"a", "d", "b"}, m.Keys())
+
+	m.FilterSelfByKey(func(key string) bool { return key != "b" })
+	assert.Equal(t, []string{"a", "d"}, m.Keys())
+
+	m.FilterSelfByValue(func(value int) bool { return value == 3 })
+	assert.Equal(t, []Entry[string, int]{{Key: "d", Value: 3}}, m.Entries())
+}
+
+func TestOrderedMap_Copy(t *testing.T) {
+	m := OrderedFromEntries(orderedEntries)
+	copyMap := m.Copy()
+
+	copyMap.Set("e", 5)
+	assert.Equal(t, orderedEntries, m.Entries())
+	assert.Equal(t, append(append([]Entry[string, int]{}, orderedEntries...), NewEntry("e", 5)), copyMap.Entries())
+}
+
+func TestOrderedMap_Merge(t *testing.T) {
+	this := OrderedFromEntry(NewEntry("a", 1), NewEntry("b", 2))
+	other := OrderedFromEntry(NewEntry("c", 3), NewEntry("a", 4))
+
+	assert.Equal(t,
+		[]Entry[string, int]{{Key: "a", Value: 4}, {Key: "b", Value: 2}, {Key: "c", Value: 3}},
+		this.Merge(other).Entries(),
+	)
+	assert.Equal(t,
+		[]Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "c", Value: 3}},
+		this.MergeLeft(other).Entries(),
+	)
+	assert.Equal(t, []Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, this.Entries())
+
+	var nilMap *OrderedMap[string, int]
+	assert.Equal(t, other.Entries(), nilMap.Merge(other).Entries())
+	assert.Equal(t, other.Entries(), nilMap.MergeLeft(other).Entries())
+	assert.Equal(t, this.Entries(), this.Merge(nil).Entries())
+
+	this.MergeSelfLeft(other)
+	assert.Equal(t,
+		[]Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "c", Value: 3}},
+		this.Entries(),
+	)
+
+	this.MergeSelf(other)
+	assert.Equal(t,
+		[]Entry[string, int]{{Key: "a", Value: 4}, {Key: "b", Value: 2}, {Key: "c", Value: 3}},
+		this.Entries(),
+	)
+}
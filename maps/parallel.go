@@ -0,0 +1,221 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ParallelError represents all errors that occurred during parallel processing of map, including context error if
+// processing was canceled
+type ParallelError struct {
+	Errors []error
+}
+
+// Error returns messages of all errors
+func (e *ParallelError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return "parallel: " + strings.Join(messages, "; ")
+}
+
+// Is returns true if any of errors matches target
+func (e *ParallelError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of errors that matches target, and if so, sets target to that error value and returns true
+func (e *ParallelError) As(target any) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns all errors
+func (e *ParallelError) Unwrap() []error {
+	return e.Errors
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// ParallelFilter returns new map from this, filtered by key and value using provided predicate called concurrently
+// by specified number of workers (GOMAXPROCS if not positive), entries are not processed after context is done,
+// returns *ParallelError with all predicate errors and context error if any occurred
+func (m Map[K, V]) ParallelFilter(
+	ctx context.Context, workers int, predicate func(ctx context.Context, key K, value V) (bool, error),
+) (Map[K, V], error) {
+	workers = parallelWorkers(workers, len(m))
+	results := make([]Map[K, V], workers)
+	for i := range results {
+		results[i] = make(Map[K, V])
+	}
+
+	err := parallelProcess(ctx, m, workers, func(worker int, key K, value V) error {
+		matched, err := predicate(ctx, key, value)
+		if err != nil {
+			return err
+		}
+
+		if matched {
+			results[worker][key] = value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		return nil, nil
+	}
+	return MergeAll(results...), nil
+}
+
+// ParallelFilter returns new map from specified, filtered by key and value using provided predicate called
+// concurrently by specified number of workers (GOMAXPROCS if not positive), entries are not processed after context
+// is done, returns *ParallelError with all predicate errors and context error if any occurred
+func ParallelFilter[K comparable, V any](
+	ctx context.Context, m Map[K, V], workers int, predicate func(ctx context.Context, key K, value V) (bool, error),
+) (Map[K, V], error) {
+	return m.ParallelFilter(ctx, workers, predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// ParallelMapValues returns new map from specified with the same keys and values converted using provided mapper
+// called concurrently by specified number of workers (GOMAXPROCS if not positive), entries are not processed after
+// context is done, returns *ParallelError with all mapper errors and context error if any occurred
+func ParallelMapValues[K comparable, V, R any](
+	ctx context.Context, m Map[K, V], workers int, mapper func(ctx context.Context, key K, value V) (R, error),
+) (Map[K, R], error) {
+	workers = parallelWorkers(workers, len(m))
+	results := make([]Map[K, R], workers)
+	for i := range results {
+		results[i] = make(Map[K, R])
+	}
+
+	err := parallelProcess(ctx, m, workers, func(worker int, key K, value V) error {
+		mapped, err := mapper(ctx, key, value)
+		if err != nil {
+			return err
+		}
+
+		results[worker][key] = mapped
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		return nil, nil
+	}
+	return MergeAll(results...), nil
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// ParallelForEach calls provided action on each entry of this map concurrently by specified number of workers
+// (GOMAXPROCS if not positive), entries are not processed after context is done, returns *ParallelError with all
+// action errors and context error if any occurred
+func (m Map[K, V]) ParallelForEach(
+	ctx context.Context, workers int, action func(ctx context.Context, key K, value V) error,
+) error {
+	return parallelProcess(ctx, m, parallelWorkers(workers, len(m)), func(_ int, key K, value V) error {
+		return action(ctx, key, value)
+	})
+}
+
+// ParallelForEach calls provided action on each entry of specified map concurrently by specified number of workers
+// (GOMAXPROCS if not positive), entries are not processed after context is done, returns *ParallelError with all
+// action errors and context error if any occurred
+func ParallelForEach[K comparable, V any](
+	ctx context.Context, m Map[K, V], workers int, action func(ctx context.Context, key K, value V) error,
+) error {
+	return m.ParallelForEach(ctx, workers, action)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// parallelWorkers returns number of workers to use, GOMAXPROCS if not positive, but no more than number of entries
+func parallelWorkers(workers, entries int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > entries {
+		workers = entries
+	}
+	return workers
+}
+
+// parallelProcess calls process on each entry of map by specified number of workers, passing index of worker, so
+// each worker can store results separately, stops processing entries once context is done and reports context error
+// only if some entries were not processed
+func parallelProcess[K comparable, V any](
+	ctx context.Context, m Map[K, V], workers int, process func(worker int, key K, value V) error,
+) error {
+	entries := make(chan Entry[K, V])
+	workerErrors := make([][]error, workers)
+	workerSkipped := make([]bool, workers)
+
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func(worker int) {
+			defer wg.Done()
+
+			for entry := range entries {
+				if ctx.Err() != nil {
+					workerSkipped[worker] = true
+					continue
+				}
+
+				if err := process(worker, entry.Key, entry.Value); err != nil {
+					workerErrors[worker] = append(workerErrors[worker], err)
+				}
+			}
+		}(worker)
+	}
+
+	canceled := false
+feeding:
+	for key, value := range m {
+		select {
+		case <-ctx.Done():
+			canceled = true
+			break feeding
+		case entries <- Entry[K, V]{Key: key, Value: value}:
+		}
+	}
+	close(entries)
+	wg.Wait()
+
+	var errs []error
+	for worker, errsOfWorker := range workerErrors {
+		errs = append(errs, errsOfWorker...)
+		canceled = canceled || workerSkipped[worker]
+	}
+	if canceled {
+		errs = append(errs, ctx.Err())
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ParallelError{
+		Errors: errs,
+	}
+}
@@ -0,0 +1,149 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parallelTestMap(size int) Map[int, int] {
+	m := make(Map[int, int], size)
+	for i := 0; i < size; i++ {
+		m[i] = i * 10
+	}
+	return m
+}
+
+func TestM_ParallelFilter(t *testing.T) {
+	m := parallelTestMap(1000)
+	predicate := func(_ context.Context, key, value int) (bool, error) {
+		return key%3 == 0 && value%2 == 0, nil
+	}
+	expected := m.Filter(func(key, value int) bool {
+		matched, _ := predicate(context.Background(), key, value)
+		return matched
+	})
+
+	for _, workers := range []int{-1, 0, 1, 4, 2000} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			filtered, err := m.ParallelFilter(context.Background(), workers, predicate)
+			require.NoError(t, err)
+			assert.Equal(t, expected, filtered)
+
+			filtered, err = ParallelFilter(context.Background(), m, workers, predicate)
+			require.NoError(t, err)
+			assert.Equal(t, expected, filtered)
+		})
+	}
+
+	filtered, err := Map[int, int](nil).ParallelFilter(context.Background(), 2, predicate)
+	require.NoError(t, err)
+	assert.Nil(t, filtered)
+
+	filtered, err = Map[int, int]{}.ParallelFilter(context.Background(), 2, predicate)
+	require.NoError(t, err)
+	assert.Equal(t, Map[int, int]{}, filtered)
+}
+
+func TestParallelMapValues(t *testing.T) {
+	m := parallelTestMap(500)
+	mapper := func(_ context.Context, _ int, value int) (string, error) {
+		return strconv.Itoa(value), nil
+	}
+
+	mapped, err := ParallelMapValues(context.Background(), m, 3, mapper)
+	require.NoError(t, err)
+	assert.Equal(t, MapValues(m, strconv.Itoa), mapped)
+
+	mapped, err = ParallelMapValues(context.Background(), Map[int, int](nil), 3, mapper)
+	require.NoError(t, err)
+	assert.Nil(t, mapped)
+}
+
+func TestM_ParallelForEach(t *testing.T) {
+	m := parallelTestMap(500)
+
+	var sum int64
+	err := m.ParallelForEach(context.Background(), 4, func(_ context.Context, _ int, value int) error {
+		atomic.AddInt64(&sum, int64(value))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(SumValues(m)), sum)
+
+	sum = 0
+	err = ParallelForEach(context.Background(), m, 0, func(_ context.Context, key int, _ int) error {
+		atomic.AddInt64(&sum, int64(key))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(499*500/2), sum)
+}
+
+func TestParallel_Errors(t *testing.T) {
+	m := parallelTestMap(100)
+	errOdd := errors.New("odd")
+
+	_, err := m.ParallelFilter(context.Background(), 4, func(_ context.Context, key, _ int) (bool, error) {
+		if key%2 == 1 {
+			return false, fmt.Errorf("key %d: %w", key, errOdd)
+		}
+		return true, nil
+	})
+
+	var parallelErr *ParallelError
+	require.True(t, errors.As(err, &parallelErr))
+	assert.Len(t, parallelErr.Errors, 50)
+	assert.Len(t, parallelErr.Unwrap(), 50)
+	assert.ErrorIs(t, err, errOdd)
+	assert.NotErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "parallel: key ")
+
+	_, err = ParallelMapValues(context.Background(), m, 4, func(_ context.Context, key, value int) (int, error) {
+		if key == 42 {
+			return 0, errOdd
+		}
+		return value, nil
+	})
+	assert.ErrorIs(t, err, errOdd)
+	assert.Equal(t, "parallel: odd", err.Error())
+}
+
+func TestParallel_Cancel(t *testing.T) {
+	m := parallelTestMap(10000)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var processed int64
+	err := m.ParallelForEach(ctx, 2, func(_ context.Context, _ int, _ int) error {
+		if atomic.AddInt64(&processed, 1) == 10 {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, atomic.LoadInt64(&processed), int64(len(m)))
+
+	canceled, cancelCanceled := context.WithCancel(context.Background())
+	cancelCanceled()
+
+	filtered, err := m.ParallelFilter(canceled, 4, func(_ context.Context, _ int, _ int) (bool, error) {
+		return true, nil
+	})
+	assert.Nil(t, filtered)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	var target *ParallelError
+	assert.True(t, errors.As(err, &target))
+
+	var notFound *ConflictError
+	assert.False(t, errors.As(err, &notFound))
+}
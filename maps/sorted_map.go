@@ -0,0 +1,539 @@
+package maps
+
+import "github.com/mymmrac/aki/constraints"
+
+const (
+	// sortedMapMaxLevel is max number of skip list levels, enough for 4^32 entries
+	sortedMapMaxLevel = 32
+
+	// sortedMapLevelMask is used to promote node to next level with probability 1/4
+	sortedMapLevelMask = 3
+
+	// sortedMapSeed is initial state of level generator, it must not be zero
+	sortedMapSeed = 0x9e3779b97f4a7c15
+)
+
+// sortedMapNode represents node of sorted map skip list
+type sortedMapNode[K constraints.Ordered, V any] struct {
+	key   K
+	value V
+	prev  *sortedMapNode[K, V]
+	next  []*sortedMapNode[K, V]
+}
+
+// SortedMap represents generic map that keeps its keys in ascending order, backed by skip list, zero value is an
+// empty map ready to use
+//
+// Note: Float keys must not be NaN, since NaN is not ordered
+type SortedMap[K constraints.Ordered, V any] struct {
+	head   *sortedMapNode[K, V]
+	tail   *sortedMapNode[K, V]
+	level  int
+	length int
+	seed   uint64
+}
+
+// NewSortedMap creates new empty sorted map
+func NewSortedMap[K constraints.Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// SortedFromEntries creates new sorted map filled with entries
+func SortedFromEntries[K constraints.Ordered, V any](entries []Entry[K, V]) *SortedMap[K, V] {
+	return NewSortedMap[K, V]().FillEntries(entries)
+}
+
+// SortedFromEntry creates new sorted map filled with entries
+func SortedFromEntry[K constraints.Ordered, V any](entries ...Entry[K, V]) *SortedMap[K, V] {
+	return SortedFromEntries(entries)
+}
+
+// SortedFromMap creates new sorted map filled with values of specified map
+func SortedFromMap[K constraints.Ordered, V any](m Map[K, V]) *SortedMap[K, V] {
+	sortedMap := NewSortedMap[K, V]()
+	for key, value := range m {
+		sortedMap.Set(key, value)
+	}
+	return sortedMap
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Len returns number of entries in this map
+func (m *SortedMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return m.length
+}
+
+// Get returns value stored by key and true if key is present
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	node := m.find(key)
+	if node == nil {
+		var empty V
+		return empty, false
+	}
+	return node.value, true
+}
+
+// Set stores value by key
+func (m *SortedMap[K, V]) Set(key K, value V) {
+	if m.head == nil {
+		m.head = &sortedMapNode[K, V]{
+			next: make([]*sortedMapNode[K, V], sortedMapMaxLevel),
+		}
+		m.level = 1
+	}
+
+	var update [sortedMapMaxLevel]*sortedMapNode[K, V]
+	predecessor := m.predecessors(key, &update)
+
+	if next := predecessor.next[0]; next != nil && next.key == key {
+		next.value = value
+		return
+	}
+
+	level := m.randomLevel()
+	if level > m.level {
+		for i := m.level; i < level; i++ {
+			update[i] = m.head
+		}
+		m.level = level
+	}
+
+	node := &sortedMapNode[K, V]{
+		key:   key,
+		value: value,
+		next:  make([]*sortedMapNode[K, V], level),
+	}
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+
+	if predecessor != m.head {
+		node.prev = predecessor
+	}
+	if node.next[0] == nil {
+		m.tail = node
+	} else {
+		node.next[0].prev = node
+	}
+
+	m.length++
+}
+
+// Delete removes key from this map and returns true if key was present
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	if m.Len() == 0 {
+		return false
+	}
+
+	var update [sortedMapMaxLevel]*sortedMapNode[K, V]
+	node := m.predecessors(key, &update).next[0]
+	if node == nil || node.key != key {
+		return false
+	}
+
+	m.unlink(node, &update)
+	return true
+}
+
+// ContainsKey returns true if key is present in this map
+func (m *SortedMap[K, V]) ContainsKey(key K) bool {
+	return m.find(key) != nil
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// First returns entry with the smallest key and true if map is not empty
+func (m *SortedMap[K, V]) First() (Entry[K, V], bool) {
+	return m.first().entry()
+}
+
+// Last returns entry with the largest key and true if map is not empty
+func (m *SortedMap[K, V]) Last() (Entry[K, V], bool) {
+	if m == nil {
+		return Entry[K, V]{}, false
+	}
+	return m.tail.entry()
+}
+
+// PopFirst removes and returns entry with the smallest key and true if map was not empty
+func (m *SortedMap[K, V]) PopFirst() (Entry[K, V], bool) {
+	entry, found := m.First()
+	if found {
+		m.Delete(entry.Key)
+	}
+	return entry, found
+}
+
+// PopLast removes and returns entry with the largest key and true if map was not empty
+func (m *SortedMap[K, V]) PopLast() (Entry[K, V], bool) {
+	entry, found := m.Last()
+	if found {
+		m.Delete(entry.Key)
+	}
+	return entry, found
+}
+
+// Floor returns entry with the largest key less than or equal to provided key and true if such entry exists
+func (m *SortedMap[K, V]) Floor(key K) (Entry[K, V], bool) {
+	predecessor := m.predecessor(key)
+	if predecessor == nil {
+		return Entry[K, V]{}, false
+	}
+
+	if next := predecessor.next[0]; next != nil && next.key == key {
+		return next.entry()
+	}
+	return m.notHead(predecessor).entry()
+}
+
+// Ceiling returns entry with the smallest key greater than or equal to provided key and true if such entry exists
+func (m *SortedMap[K, V]) Ceiling(key K) (Entry[K, V], bool) {
+	predecessor := m.predecessor(key)
+	if predecessor == nil {
+		return Entry[K, V]{}, false
+	}
+	return predecessor.next[0].entry()
+}
+
+// Lower returns entry with the largest key strictly less than provided key and true if such entry exists
+func (m *SortedMap[K, V]) Lower(key K) (Entry[K, V], bool) {
+	predecessor := m.predecessor(key)
+	if predecessor == nil {
+		return Entry[K, V]{}, false
+	}
+	return m.notHead(predecessor).entry()
+}
+
+// Higher returns entry with the smallest key strictly greater than provided key and true if such entry exists
+func (m *SortedMap[K, V]) Higher(key K) (Entry[K, V], bool) {
+	predecessor := m.predecessor(key)
+	if predecessor == nil {
+		return Entry[K, V]{}, false
+	}
+
+	next := predecessor.next[0]
+	if next != nil && next.key == key {
+		next = next.next[0]
+	}
+	return next.entry()
+}
+
+// Range returns entries with keys in range [from, to) in ascending order
+func (m *SortedMap[K, V]) Range(from, to K) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0)
+
+	predecessor := m.predecessor(from)
+	if predecessor == nil {
+		return entries
+	}
+
+	for node := predecessor.next[0]; node != nil && node.key < to; node = node.next[0] {
+		entries = append(entries, Entry[K, V]{
+			Key:   node.key,
+			Value: node.value,
+		})
+	}
+	return entries
+}
+
+// Ascend calls function for each entry of this map in ascending order of keys until it returns false
+func (m *SortedMap[K, V]) Ascend(f func(key K, value V) bool) {
+	for node := m.first(); node != nil; node = node.next[0] {
+		if !f(node.key, node.value) {
+			return
+		}
+	}
+}
+
+// Descend calls function for each entry of this map in descending order of keys until it returns false
+func (m *SortedMap[K, V]) Descend(f func(key K, value V) bool) {
+	if m == nil {
+		return
+	}
+
+	for node := m.tail; node != nil; node = node.prev {
+		if !f(node.key, node.value) {
+			return
+		}
+	}
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Keys returns keys of this map in ascending order
+func (m *SortedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for node := m.first(); node != nil; node = node.next[0] {
+		keys = append(keys, node.key)
+	}
+	return keys
+}
+
+// Values returns values of this map in ascending order of their keys
+func (m *SortedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for node := m.first(); node != nil; node = node.next[0] {
+		values = append(values, node.value)
+	}
+	return values
+}
+
+// Entries returns entries of this map in ascending order of keys
+func (m *SortedMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	for node := m.first(); node != nil; node = node.next[0] {
+		entries = append(entries, Entry[K, V]{
+			Key:   node.key,
+			Value: node.value,
+		})
+	}
+	return entries
+}
+
+// FillEntries fills entries into this map
+func (m *SortedMap[K, V]) FillEntries(entries []Entry[K, V]) *SortedMap[K, V] {
+	for _, entry := range entries {
+		m.Set(entry.Key, entry.Value)
+	}
+	return m
+}
+
+// FillEntry fills entries into this map
+func (m *SortedMap[K, V]) FillEntry(entries ...Entry[K, V]) *SortedMap[K, V] {
+	return m.FillEntries(entries)
+}
+
+// Map returns regular map with entries of this map
+func (m *SortedMap[K, V]) Map() Map[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	result := make(Map[K, V], m.length)
+	for node := m.first(); node != nil; node = node.next[0] {
+		result[node.key] = node.value
+	}
+	return result
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Filter returns new map from this, filtered by key and value using provided predicate
+func (m *SortedMap[K, V]) Filter(predicate Predicate[K, V]) *SortedMap[K, V] {
+	if m == nil {
+		return nil
+	}
+
+	filtered := NewSortedMap[K, V]()
+	for node := m.first(); node != nil; node = node.next[0] {
+		if predicate(node.key, node.value) {
+			filtered.Set(node.key, node.value)
+		}
+	}
+	return filtered
+}
+
+// FilterSelf removes entries from this map that do not match provided predicate
+func (m *SortedMap[K, V]) FilterSelf(predicate Predicate[K, V]) *SortedMap[K, V] {
+	for node := m.first(); node != nil; node = node.next[0] {
+		if !predicate(node.key, node.value) {
+			m.Delete(node.key)
+		}
+	}
+	return m
+}
+
+// FilterByKey returns new map from this, filtered by key using provided predicate
+func (m *SortedMap[K, V]) FilterByKey(predicate PredicateByKey[K]) *SortedMap[K, V] {
+	return m.Filter(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterSelfByKey removes entries from this map which keys do not match provided predicate
+func (m *SortedMap[K, V]) FilterSelfByKey(predicate PredicateByKey[K]) *SortedMap[K, V] {
+	return m.FilterSelf(func(key K, _ V) bool {
+		return predicate(key)
+	})
+}
+
+// FilterByValue returns new map from this, filtered by value using provided predicate
+func (m *SortedMap[K, V]) FilterByValue(predicate PredicateByValue[V]) *SortedMap[K, V] {
+	return m.Filter(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// FilterSelfByValue removes entries from this map which values do not match provided predicate
+func (m *SortedMap[K, V]) FilterSelfByValue(predicate PredicateByValue[V]) *SortedMap[K, V] {
+	return m.FilterSelf(func(_ K, value V) bool {
+		return predicate(value)
+	})
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Copy returns shallow copy of this map
+func (m *SortedMap[K, V]) Copy() *SortedMap[K, V] {
+	if m == nil {
+		return nil
+	}
+	return NewSortedMap[K, V]().MergeSelf(m)
+}
+
+// Merge returns new map with values from provided map merged into copy of this
+func (m *SortedMap[K, V]) Merge(other *SortedMap[K, V]) *SortedMap[K, V] {
+	merged := m.Copy()
+	if merged == nil {
+		merged = NewSortedMap[K, V]()
+	}
+	return merged.MergeSelf(other)
+}
+
+// MergeSelf merges values from provided map into this
+func (m *SortedMap[K, V]) MergeSelf(other *SortedMap[K, V]) *SortedMap[K, V] {
+	for node := other.first(); node != nil; node = node.next[0] {
+		m.Set(node.key, node.value)
+	}
+	return m
+}
+
+// MergeLeft returns new map with values from provided map merged into copy of this, keeping values of this on
+// conflicts
+func (m *SortedMap[K, V]) MergeLeft(other *SortedMap[K, V]) *SortedMap[K, V] {
+	merged := m.Copy()
+	if merged == nil {
+		merged = NewSortedMap[K, V]()
+	}
+	return merged.MergeSelfLeft(other)
+}
+
+// MergeSelfLeft merges values from provided map into this, keeping values of this on conflicts
+func (m *SortedMap[K, V]) MergeSelfLeft(other *SortedMap[K, V]) *SortedMap[K, V] {
+	for node := other.first(); node != nil; node = node.next[0] {
+		if m.ContainsKey(node.key) {
+			continue
+		}
+
+		m.Set(node.key, node.value)
+	}
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// first returns node with the smallest key or nil if map is nil or empty
+func (m *SortedMap[K, V]) first() *sortedMapNode[K, V] {
+	if m == nil || m.head == nil {
+		return nil
+	}
+	return m.head.next[0]
+}
+
+// notHead returns node itself or nil if node is head of this map
+func (m *SortedMap[K, V]) notHead(node *sortedMapNode[K, V]) *sortedMapNode[K, V] {
+	if node == m.head {
+		return nil
+	}
+	return node
+}
+
+// find returns node stored by key or nil if key is not present
+func (m *SortedMap[K, V]) find(key K) *sortedMapNode[K, V] {
+	predecessor := m.predecessor(key)
+	if predecessor == nil {
+		return nil
+	}
+
+	if next := predecessor.next[0]; next != nil && next.key == key {
+		return next
+	}
+	return nil
+}
+
+// predecessor returns the last node with key strictly less than provided key (head if there is no such node) or
+// nil if map is nil or empty
+func (m *SortedMap[K, V]) predecessor(key K) *sortedMapNode[K, V] {
+	if m.Len() == 0 {
+		return nil
+	}
+
+	node := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].key < key {
+			node = node.next[i]
+		}
+	}
+	return node
+}
+
+// predecessors fills the last nodes with key strictly less than provided key on each level and returns the one from
+// the lowest level
+func (m *SortedMap[K, V]) predecessors(key K, update *[sortedMapMaxLevel]*sortedMapNode[K, V]) *sortedMapNode[K, V] {
+	node := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].key < key {
+			node = node.next[i]
+		}
+		update[i] = node
+	}
+	return node
+}
+
+// unlink removes node from skip list using its predecessors on each level
+func (m *SortedMap[K, V]) unlink(node *sortedMapNode[K, V], update *[sortedMapMaxLevel]*sortedMapNode[K, V]) {
+	for i := 0; i < m.level; i++ {
+		if update[i].next[i] != node {
+			break
+		}
+		update[i].next[i] = node.next[i]
+	}
+
+	if node.next[0] == nil {
+		m.tail = node.prev
+	} else {
+		node.next[0].prev = node.prev
+	}
+
+	for m.level > 1 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+	m.length--
+}
+
+// randomLevel returns level for new node using xorshift generator
+func (m *SortedMap[K, V]) randomLevel() int {
+	if m.seed == 0 {
+		m.seed = sortedMapSeed
+	}
+
+	level := 1
+	for level < sortedMapMaxLevel {
+		m.seed ^= m.seed << 13
+		m.seed ^= m.seed >> 7
+		m.seed ^= m.seed << 17
+
+		if m.seed&sortedMapLevelMask != 0 {
+			break
+		}
+		level++
+	}
+	return level
+}
+
+// entry returns entry of node and true if node is not nil
+func (n *sortedMapNode[K, V]) entry() (Entry[K, V], bool) {
+	if n == nil {
+		return Entry[K, V]{}, false
+	}
+
+	return Entry[K, V]{
+		Key:   n.key,
+		Value: n.value,
+	}, true
+}
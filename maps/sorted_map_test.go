@@ -0,0 +1,215 @@
+package maps
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedMap_SetGetDelete(t *testing.T) {
+	var m SortedMap[int, string]
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Delete(1))
+
+	m.Set(3, "c")
+	m.Set(1, "a")
+	m.Set(2, "b")
+	m.Set(1, "aa")
+
+	value, found := m.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "aa", value)
+
+	_, found = m.Get(4)
+	assert.False(t, found)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, []int{1, 2, 3}, m.Keys())
+	assert.Equal(t, []string{"aa", "b", "c"}, m.Values())
+
+	assert.True(t, m.Delete(2))
+	assert.False(t, m.Delete(2))
+	assert.False(t, m.ContainsKey(2))
+	assert.Equal(t, []Entry[int, string]{{Key: 1, Value: "aa"}, {Key: 3, Value: "c"}}, m.Entries())
+}
+
+func TestSortedMap_Nil(t *testing.T) {
+	var m *SortedMap[int, string]
+
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.ContainsKey(1))
+	assert.False(t, m.Delete(1))
+	assert.Equal(t, []int{}, m.Keys())
+	assert.Equal(t, []Entry[int, string]{}, m.Range(0, 10))
+	assert.Nil(t, m.Copy())
+	assert.Nil(t, m.Map())
+	assert.Nil(t, m.Filter(func(_ int, _ string) bool { return true }))
+
+	_, found := m.First()
+	assert.False(t, found)
+	_, found = m.Last()
+	assert.False(t, found)
+	_, found = m.Floor(1)
+	assert.False(t, found)
+	_, found = m.Ceiling(1)
+	assert.False(t, found)
+	_, found = m.Lower(1)
+	assert.False(t, found)
+	_, found = m.Higher(1)
+	assert.False(t, found)
+
+	m.Descend(func(_ int, _ string) bool {
+		t.Fail()
+		return true
+	})
+}
+
+func TestSortedMap_Navigation(t *testing.T) {
+	m := SortedFromEntry(NewEntry(10, "a"), NewEntry(20, "b"), NewEntry(30, "c"))
+
+	tests := []struct {
+		name     string
+		method   func(key int) (Entry[int, string], bool)
+		key      int
+		expected int
+		found    bool
+	}{
+		{name: "floor_less", method: m.Floor, key: 5, found: false},
+		{name: "floor_equal", method: m.Floor, key: 20, expected: 20, found: true},
+		{name: "floor_between", method: m.Floor, key: 25, expected: 20, found: true},
+		{name: "floor_greater", method: m.Floor, key: 35, expected: 30, found: true},
+		{name: "ceiling_less", method: m.Ceiling, key: 5, expected: 10, found: true},
+		{name: "ceiling_equal", method: m.Ceiling, key: 20, expected: 20, found: true},
+		{name: "ceiling_between", method: m.Ceiling, key: 25, expected: 30, found: true},
+		{name: "ceiling_greater", method: m.Ceiling, key: 35, found: false},
+		{name: "lower_equal", method: m.Lower, key: 20, expected: 10, found: true},
+		{name: "lower_first", method: m.Lower, key: 10, found: false},
+		{name: "higher_equal", method: m.Higher, key: 20, expected: 30, found: true},
+		{name: "higher_last", method: m.Higher, key: 30, found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, found := tt.method(tt.key)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, entry.Key)
+		})
+	}
+
+	assert.Equal(t, []Entry[int, string]{{Key: 10, Value: "a"}, {Key: 20, Value: "b"}}, m.Range(10, 30))
+	assert.Equal(t, []Entry[int, string]{{Key: 20, Value: "b"}}, m.Range(11, 21))
+	assert.Equal(t, []Entry[int, string]{}, m.Range(31, 40))
+	assert.Equal(t, []Entry[int, string]{}, m.Range(20, 10))
+
+	first, found := m.First()
+	assert.True(t, found)
+	assert.Equal(t, NewEntry(10, "a"), first)
+
+	last, found := m.Last()
+	assert.True(t, found)
+	assert.Equal(t, NewEntry(30, "c"), last)
+}
+
+func TestSortedMap_Pop(t *testing.T) {
+	m := SortedFromMap(Map[int, int]{3: 3, 1: 1, 2: 2})
+
+	entry, found := m.PopFirst()
+	assert.True(t, found)
+	assert.Equal(t, NewEntry(1, 1), entry)
+
+	entry, found = m.PopLast()
+	assert.True(t, found)
+	assert.Equal(t, NewEntry(3, 3), entry)
+
+	entry, found = m.PopLast()
+	assert.True(t, found)
+	assert.Equal(t, NewEntry(2, 2), entry)
+
+	_, found = m.PopFirst()
+	assert.False(t, found)
+	_, found = m.PopLast()
+	assert.False(t, found)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSortedMap_Iteration(t *testing.T) {
+	m := SortedFromMap(Map[string, int]{"b": 2, "a": 1, "c": 3})
+
+	var keys []string
+	m.Ascend(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return key != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, keys)
+
+	keys = nil
+	m.Descend(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+}
+
+func TestSortedMap_FilterMerge(t *testing.T) {
+	m := SortedFromMap(Map[int, int]{1: 1, 2: 2, 3: 3, 4: 4})
+
+	assert.Equal(t, []int{1, 3}, m.Filter(func(key, value int) bool { return key == 1 || value == 3 }).Keys())
+	assert.Equal(t, []int{2}, m.FilterByKey(func(key int) bool { return key == 2 }).Keys())
+	assert.Equal(t, []int{4}, m.FilterByValue(func(value int) bool { return value == 4 }).Keys())
+
+	copyMap := m.Copy()
+	copyMap.FilterSelf(func(key, _ int) bool { return key > 1 })
+	copyMap.FilterSelfByKey(func(key int) bool { return key < 4 })
+	copyMap.FilterSelfByValue(func(value int) bool { return value != 3 })
+	assert.Equal(t, Map[int, int]{2: 2}, copyMap.Map())
+	assert.Equal(t, Map[int, int]{1: 1, 2: 2, 3: 3, 4: 4}, m.Map())
+
+	other := SortedFromEntry(NewEntry(1, 10), NewEntry(5, 50))
+	assert.Equal(t, []int{10, 2, 3, 4, 50}, m.Merge(other).Values())
+	assert.Equal(t, []int{1, 2, 3, 4, 50}, m.MergeLeft(other).Values())
+
+	var nilMap *SortedMap[int, int]
+	assert.Equal(t, other.Entries(), nilMap.Merge(other).Entries())
+	assert.Equal(t, other.Entries(), nilMap.MergeLeft(other).Entries())
+
+	m.MergeSelfLeft(other)
+	assert.Equal(t, []int{1, 2, 3, 4, 50}, m.Values())
+	m.MergeSelf(other)
+	assert.Equal(t, []int{10, 2, 3, 4, 50}, m.Values())
+}
+
+func TestSortedMap_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(42)) //nolint:gosec
+	m := NewSortedMap[int, int]()
+	expected := Map[int, int]{}
+
+	for i := 0; i < 10000; i++ {
+		key := random.Intn(1000)
+		if random.Intn(3) == 0 {
+			assert.Equal(t, expected.ContainsKey(key), m.Delete(key))
+			delete(expected, key)
+			continue
+		}
+
+		m.Set(key, i)
+		expected[key] = i
+	}
+
+	keys := expected.Keys()
+	sort.Ints(keys)
+
+	assert.Equal(t, len(expected), m.Len())
+	assert.Equal(t, keys, m.Keys())
+	assert.Equal(t, expected, m.Map())
+
+	var descending []int
+	m.Descend(func(key int, _ int) bool {
+		descending = append(descending, key)
+		return true
+	})
+	for i := range keys {
+		assert.Equal(t, keys[len(keys)-1-i], descending[i])
+	}
+}
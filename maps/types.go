@@ -1,6 +1,9 @@
 package maps
 
-import "github.com/mymmrac/aki/types"
+import (
+	"github.com/mymmrac/aki/sets"
+	"github.com/mymmrac/aki/types"
+)
 
 // Map represents generic map with useful methods
 type Map[K comparable, V any] map[K]V
@@ -27,6 +30,32 @@ func ToCloneableMap[K comparable, T any, V types.Cloneable[T]](m map[K]V) Clonea
 
 // ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
 
+// ClonerMap represents generic map with values that can clone themselves without type assertions, it replaces
+// CloneableMap, whose Clone panics if Clone of value returns value of other type
+type ClonerMap[K comparable, V types.Cloner[V]] map[K]V
+
+func ToClonerMap[K comparable, V types.Cloner[V]](m map[K]V) ClonerMap[K, V] {
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// MultiMap represents generic map of keys to multiple values, values of key are kept in insertion order and may repeat
+type MultiMap[K, V comparable] map[K][]V
+
+func ToMultiMap[K, V comparable](m map[K][]V) MultiMap[K, V] {
+	return m
+}
+
+// SetMultiMap represents generic map of keys to multiple unique values
+type SetMultiMap[K, V comparable] map[K]sets.Set[V]
+
+func ToSetMultiMap[K, V comparable](m map[K]sets.Set[V]) SetMultiMap[K, V] {
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
 // Entry represents generic map entry
 type Entry[K comparable, V any] struct {
 	Key   K
@@ -50,3 +79,17 @@ type PredicateByKey[K comparable] func(key K) bool
 
 // PredicateByValue defines map predicate by value
 type PredicateByValue[V any] func(values V) bool
+
+// PredicateE defines map predicate that can fail
+type PredicateE[K comparable, V any] func(key K, values V) (bool, error)
+
+// PredicateByKeyE defines map predicate by key that can fail
+type PredicateByKeyE[K comparable] func(key K) (bool, error)
+
+// PredicateByValueE defines map predicate by value that can fail
+type PredicateByValueE[V any] func(values V) (bool, error)
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// MergeResolver defines function that combines values of the same key present in both merged maps
+type MergeResolver[K comparable, V any] func(key K, left, right V) V
@@ -0,0 +1,132 @@
+/*
+Package option provides generic optional value type, methods & functions.
+*/
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Option represents generic optional value that is either present (some) or absent (none), zero value is none
+type Option[T any] struct {
+	value T
+	some  bool
+}
+
+// Some creates new option with present value
+func Some[T any](value T) Option[T] {
+	return Option[T]{
+		value: value,
+		some:  true,
+	}
+}
+
+// None creates new option with absent value
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPair creates new option from value and flag that reports presence of the value, useful for comma-ok results
+func FromPair[T any](value T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// IsSome returns true if value is present
+func (o Option[T]) IsSome() bool {
+	return o.some
+}
+
+// IsNone returns true if value is absent
+func (o Option[T]) IsNone() bool {
+	return !o.some
+}
+
+// Get returns value and true if it is present
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.some
+}
+
+// OrElse returns value if it is present, otherwise provided value
+func (o Option[T]) OrElse(value T) T {
+	if o.some {
+		return o.value
+	}
+	return value
+}
+
+// OrElseGet returns value if it is present, otherwise result of provided supplier, supplier is called only if value
+// is absent
+func (o Option[T]) OrElseGet(supplier func() T) T {
+	if o.some {
+		return o.value
+	}
+	return supplier()
+}
+
+// OrEmpty returns value if it is present, otherwise zero value
+func (o Option[T]) OrEmpty() T {
+	return o.value
+}
+
+// Filter returns this option if value is present and matches provided predicate, otherwise none
+func (o Option[T]) Filter(predicate func(value T) bool) Option[T] {
+	if o.some && predicate(o.value) {
+		return o
+	}
+	return None[T]()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Map returns option with value of specified option converted using provided mapper if it is present, otherwise none
+func Map[T, R any](o Option[T], mapper func(value T) R) Option[R] {
+	if !o.some {
+		return None[R]()
+	}
+	return Some(mapper(o.value))
+}
+
+// FlatMap returns result of provided mapper called on value of specified option if it is present, otherwise none
+func FlatMap[T, R any](o Option[T], mapper func(value T) Option[R]) Option[R] {
+	if !o.some {
+		return None[R]()
+	}
+	return mapper(o.value)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// jsonNull is JSON representation of absent value
+var jsonNull = []byte("null")
+
+// MarshalJSON encodes value as JSON if it is present, otherwise as null
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.some {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes JSON null as none and any other JSON as present value
+//
+// Note: Present value that is encoded as null (for example, nil pointer) is decoded as none
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
@@ -0,0 +1,119 @@
+package option
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstructors(t *testing.T) {
+	assert.Equal(t, Option[int]{value: 1, some: true}, Some(1))
+	assert.Equal(t, Option[int]{}, None[int]())
+	assert.Equal(t, Some(0), FromPair(0, true))
+	assert.Equal(t, None[int](), FromPair(1, false))
+
+	var zero Option[string]
+	assert.True(t, zero.IsNone())
+}
+
+var optionTestCases = []struct {
+	name   string
+	o      Option[int]
+	some   bool
+	value  int
+	orElse int
+}{
+	{
+		name:   "none",
+		o:      None[int](),
+		some:   false,
+		value:  0,
+		orElse: -1,
+	},
+	{
+		name:   "some_zero",
+		o:      Some(0),
+		some:   true,
+		value:  0,
+		orElse: 0,
+	},
+	{
+		name:   "some",
+		o:      Some(42),
+		some:   true,
+		value:  42,
+		orElse: 42,
+	},
+}
+
+func TestO_Accessors(t *testing.T) {
+	for _, tt := range optionTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.some, tt.o.IsSome())
+			assert.Equal(t, !tt.some, tt.o.IsNone())
+
+			value, ok := tt.o.Get()
+			assert.Equal(t, tt.some, ok)
+			assert.Equal(t, tt.value, value)
+
+			assert.Equal(t, tt.orElse, tt.o.OrElse(-1))
+			assert.Equal(t, tt.value, tt.o.OrEmpty())
+
+			called := false
+			assert.Equal(t, tt.orElse, tt.o.OrElseGet(func() int {
+				called = true
+				return -1
+			}))
+			assert.Equal(t, !tt.some, called)
+		})
+	}
+}
+
+func TestO_Transform(t *testing.T) {
+	isPositive := func(value int) bool { return value > 0 }
+	assert.Equal(t, Some(1), Some(1).Filter(isPositive))
+	assert.Equal(t, None[int](), Some(0).Filter(isPositive))
+	assert.Equal(t, None[int](), None[int]().Filter(isPositive))
+
+	assert.Equal(t, Some("1"), Map(Some(1), strconv.Itoa))
+	assert.Equal(t, None[string](), Map(None[int](), strconv.Itoa))
+
+	parse := func(value string) Option[int] {
+		number, err := strconv.Atoi(value)
+		return FromPair(number, err == nil)
+	}
+	assert.Equal(t, Some(12), FlatMap(Some("12"), parse))
+	assert.Equal(t, None[int](), FlatMap(Some("a"), parse))
+	assert.Equal(t, None[int](), FlatMap(None[string](), parse))
+}
+
+func TestO_JSON(t *testing.T) {
+	type document struct {
+		Name  Option[string]   `json:"name"`
+		Count Option[int]      `json:"count"`
+		Tags  Option[[]string] `json:"tags"`
+	}
+
+	data, err := json.Marshal(document{
+		Name:  Some("aki"),
+		Count: None[int](),
+		Tags:  Some([]string{"a"}),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"aki","count":null,"tags":["a"]}`, string(data))
+
+	var decoded document
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"", "count": null}`), &decoded))
+	assert.Equal(t, Some(""), decoded.Name)
+	assert.Equal(t, None[int](), decoded.Count)
+	assert.Equal(t, None[[]string](), decoded.Tags)
+
+	decoded.Count = Some(1)
+	require.NoError(t, json.Unmarshal([]byte(`{"count": null}`), &decoded))
+	assert.Equal(t, None[int](), decoded.Count)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"count": "a"}`), &decoded))
+}
@@ -0,0 +1,181 @@
+/*
+Package result provides generic result type, methods & functions for composing fallible operations.
+*/
+package result
+
+import (
+	"errors"
+
+	"github.com/mymmrac/aki/maps"
+	"github.com/mymmrac/aki/option"
+)
+
+// ErrNone returned by results created from absent option without specified error
+var ErrNone = errors.New("result: option has no value")
+
+// Result represents generic outcome of fallible operation that is either value (ok) or error (err), zero value is ok
+// with zero value
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok creates new result with value
+func Ok[T any](value T) Result[T] {
+	return Result[T]{
+		value: value,
+	}
+}
+
+// Err creates new result with error, nil error results in ok with zero value
+func Err[T any](err error) Result[T] {
+	return Result[T]{
+		err: err,
+	}
+}
+
+// Of creates new result from value and error, value is dropped if error is not nil
+func Of[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// FromOption creates new result with value of specified option if it is present, otherwise with provided error or
+// ErrNone if error is nil
+func FromOption[T any](o option.Option[T], err error) Result[T] {
+	value, ok := o.Get()
+	if !ok {
+		if err == nil {
+			err = ErrNone
+		}
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// IsOk returns true if result has no error
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if result has error
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unwrap returns value and error of result
+func (r Result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns error of result, nil if result is ok
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// UnwrapOr returns value if result is ok, otherwise provided value
+func (r Result[T]) UnwrapOr(value T) T {
+	if r.err != nil {
+		return value
+	}
+	return r.value
+}
+
+// UnwrapOrElse returns value if result is ok, otherwise result of provided function called with error
+func (r Result[T]) UnwrapOrElse(f func(err error) T) T {
+	if r.err != nil {
+		return f(r.err)
+	}
+	return r.value
+}
+
+// Option returns value as option, none if result has error
+func (r Result[T]) Option() option.Option[T] {
+	return option.FromPair(r.value, r.err == nil)
+}
+
+// Is returns true if error of result matches target, see errors.Is
+func (r Result[T]) Is(target error) bool {
+	return errors.Is(r.err, target)
+}
+
+// As finds the first error in error chain of result that matches target, see errors.As
+func (r Result[T]) As(target any) bool {
+	return r.err != nil && errors.As(r.err, target)
+}
+
+// Or returns this result if it is ok, otherwise provided result, like is.Or but for results
+func (r Result[T]) Or(other Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return other
+}
+
+// OrFunc returns this result if it is ok, otherwise result of provided function called with error, function is
+// called only if needed, like is.OrFunc but for results
+func (r Result[T]) OrFunc(other func(err error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return other(r.err)
+}
+
+// MapErr returns result with error converted using provided mapper if result has error, otherwise this result
+func (r Result[T]) MapErr(mapper func(err error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Err[T](mapper(r.err))
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Map returns result with value of specified result converted using provided mapper if it is ok, otherwise result
+// with the same error
+func Map[T, R any](r Result[T], mapper func(value T) R) Result[R] {
+	if r.err != nil {
+		return Err[R](r.err)
+	}
+	return Ok(mapper(r.value))
+}
+
+// AndThen returns result of provided operation called on value of specified result if it is ok, otherwise result
+// with the same error
+func AndThen[T, R any](r Result[T], operation func(value T) Result[R]) Result[R] {
+	if r.err != nil {
+		return Err[R](r.err)
+	}
+	return operation(r.value)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Collect returns values of all specified results in order, or the first error if any result has error
+func Collect[T any](results []Result[T]) ([]T, error) {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		values = append(values, r.value)
+	}
+	return values, nil
+}
+
+// CollectMap returns map of values of all specified results by keys returned from provided key selector, later
+// values overwrite earlier ones with the same key, or the first error if any result has error
+func CollectMap[T any, K comparable](results []Result[T], keySelector func(value T) K) (maps.Map[K, T], error) {
+	m := make(maps.Map[K, T], len(results))
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		m[keySelector(r.value)] = r.value
+	}
+	return m, nil
+}
@@ -0,0 +1,187 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"testing"
+
+	"github.com/mymmrac/aki/is"
+	"github.com/mymmrac/aki/maps"
+	"github.com/mymmrac/aki/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("test")
+
+func TestConstructors(t *testing.T) {
+	assert.Equal(t, Result[int]{value: 1}, Ok(1))
+	assert.Equal(t, Result[int]{err: errTest}, Err[int](errTest))
+	assert.Equal(t, Ok(1), Of(1, nil))
+	assert.Equal(t, Err[int](errTest), Of(1, errTest))
+	assert.Equal(t, Ok(1), FromOption(option.Some(1), errTest))
+	assert.Equal(t, Err[int](errTest), FromOption(option.None[int](), errTest))
+	assert.Equal(t, Err[int](ErrNone), FromOption(option.None[int](), nil))
+	assert.Equal(t, Ok(0), FromOption(option.Some(0), nil))
+
+	var zero Result[string]
+	assert.True(t, zero.IsOk())
+	assert.True(t, Err[int](nil).IsOk())
+}
+
+var resultTestCases = []struct {
+	name  string
+	r     Result[int]
+	ok    bool
+	value int
+	err   error
+	or    int
+}{
+	{
+		name:  "ok",
+		r:     Ok(42),
+		ok:    true,
+		value: 42,
+		err:   nil,
+		or:    42,
+	},
+	{
+		name:  "ok_zero",
+		r:     Ok(0),
+		ok:    true,
+		value: 0,
+		err:   nil,
+		or:    0,
+	},
+	{
+		name:  "err",
+		r:     Err[int](errTest),
+		ok:    false,
+		value: 0,
+		err:   errTest,
+		or:    -1,
+	},
+}
+
+func TestR_Accessors(t *testing.T) {
+	for _, tt := range resultTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.ok, tt.r.IsOk())
+			assert.Equal(t, !tt.ok, tt.r.IsErr())
+			assert.Equal(t, tt.err, tt.r.Err())
+
+			value, err := tt.r.Unwrap()
+			assert.Equal(t, tt.value, value)
+			assert.Equal(t, tt.err, err)
+
+			assert.Equal(t, tt.or, tt.r.UnwrapOr(-1))
+			assert.Equal(t, tt.or, tt.r.UnwrapOrElse(func(err error) int {
+				assert.Equal(t, tt.err, err)
+				return -1
+			}))
+
+			assert.Equal(t, option.FromPair(tt.value, tt.ok), tt.r.Option())
+		})
+	}
+}
+
+func TestR_Errors(t *testing.T) {
+	_, atoiErr := strconv.Atoi("a")
+	r := Err[int](fmt.Errorf("wrapped: %w", atoiErr))
+
+	assert.True(t, r.Is(strconv.ErrSyntax))
+	assert.False(t, r.Is(errTest))
+	assert.False(t, Ok(1).Is(errTest))
+
+	var numErr *strconv.NumError
+	assert.True(t, r.As(&numErr))
+	assert.Equal(t, "a", numErr.Num)
+
+	var fsErr *fs.PathError
+	assert.False(t, r.As(&fsErr))
+	assert.False(t, Ok(1).As(&numErr))
+
+	wrap := func(err error) error { return fmt.Errorf("outer: %w", err) }
+	assert.Equal(t, Ok(1), Ok(1).MapErr(wrap))
+	mapped := Err[int](errTest).MapErr(wrap)
+	assert.EqualError(t, mapped.Err(), "outer: test")
+	assert.True(t, mapped.Is(errTest))
+}
+
+func TestR_Or(t *testing.T) {
+	assert.Equal(t, Ok(1), Ok(1).Or(Ok(2)))
+	assert.Equal(t, Ok(2), Err[int](errTest).Or(Ok(2)))
+	assert.Equal(t, Err[int](ErrNone), Err[int](errTest).Or(Err[int](ErrNone)))
+
+	assert.Equal(t, Ok(1), Ok(1).OrFunc(func(_ error) Result[int] {
+		assert.Fail(t, "must not be called")
+		return Ok(2)
+	}))
+	assert.Equal(t, Ok(2), Err[int](errTest).OrFunc(func(err error) Result[int] {
+		assert.Equal(t, errTest, err)
+		return Ok(2)
+	}))
+}
+
+func TestR_IsHelpers(t *testing.T) {
+	type user struct {
+		Name string
+	}
+
+	lookup := func(u *user) Result[string] {
+		return is.IfLazy(u != nil, func() Result[string] { return Ok(u.Name) }, Err[string](ErrNone))
+	}
+	assert.Equal(t, Ok("aki"), lookup(&user{Name: "aki"}))
+	assert.Equal(t, Err[string](ErrNone), lookup(nil))
+
+	name := is.Switch[bool, string](lookup(nil).IsOk()).Case(true, "found").Default("missing")
+	assert.Equal(t, "missing", name)
+	assert.Equal(t, "fallback", is.Coalesce(lookup(nil).UnwrapOr(""), "fallback"))
+}
+
+func TestMap(t *testing.T) {
+	assert.Equal(t, Ok("1"), Map(Ok(1), strconv.Itoa))
+	assert.Equal(t, Err[string](errTest), Map(Err[int](errTest), strconv.Itoa))
+}
+
+func TestAndThen(t *testing.T) {
+	parse := func(value string) Result[int] {
+		return Of(strconv.Atoi(value))
+	}
+
+	assert.Equal(t, Ok(12), AndThen(Ok("12"), parse))
+	assert.True(t, AndThen(Ok("a"), parse).Is(strconv.ErrSyntax))
+	assert.Equal(t, Err[int](errTest), AndThen(Err[string](errTest), parse))
+}
+
+func TestCollect(t *testing.T) {
+	values, err := Collect([]Result[int]{Ok(1), Ok(2), Ok(1)})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 1}, values)
+
+	values, err = Collect[int](nil)
+	require.NoError(t, err)
+	assert.Equal(t, []int{}, values)
+
+	values, err = Collect([]Result[int]{Ok(1), Err[int](errTest), Err[int](errors.New("other"))})
+	assert.Equal(t, errTest, err)
+	assert.Nil(t, values)
+}
+
+func TestCollectMap(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	byID := func(u user) int { return u.id }
+
+	m, err := CollectMap([]Result[user]{Ok(user{1, "a"}), Ok(user{2, "b"}), Ok(user{1, "c"})}, byID)
+	require.NoError(t, err)
+	assert.Equal(t, maps.Map[int, user]{1: {1, "c"}, 2: {2, "b"}}, m)
+
+	m, err = CollectMap([]Result[user]{Ok(user{1, "a"}), Err[user](errTest)}, byID)
+	assert.Equal(t, errTest, err)
+	assert.Nil(t, m)
+}
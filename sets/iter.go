@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package sets
+
+import "iter"
+
+// ValuesSeq returns iterator over values of this set with no defined order
+func (s Set[T]) ValuesSeq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range s {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns iterator over values of specified set with no defined order
+func ValuesSeq[T comparable](s Set[T]) iter.Seq[T] {
+	return s.ValuesSeq()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Collect adds values from iterator into this set
+func (s Set[T]) Collect(seq iter.Seq[T]) Set[T] {
+	for value := range seq {
+		s[value] = struct{}{}
+	}
+	return s
+}
+
+// Collect adds values from iterator into specified set
+func Collect[T comparable](s Set[T], seq iter.Seq[T]) Set[T] {
+	return s.Collect(seq)
+}
+
+// FromSeq creates new set filled with values from iterator
+func FromSeq[T comparable](seq iter.Seq[T]) Set[T] {
+	return make(Set[T]).Collect(seq)
+}
@@ -0,0 +1,24 @@
+//go:build go1.23
+
+package sets
+
+import (
+	stdslices "slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestS_Seq(t *testing.T) {
+	s := New(3, 1, 2)
+
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Sorted(s.ValuesSeq()))
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Sorted(ValuesSeq(s)))
+
+	for range s.ValuesSeq() {
+		break
+	}
+
+	assert.Equal(t, New(1, 2), FromSeq(stdslices.Values([]int{1, 2, 1})))
+	assert.Equal(t, New(1, 2, 3), Collect(New(3), stdslices.Values([]int{1, 2})))
+}
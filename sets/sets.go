@@ -0,0 +1,246 @@
+/*
+Package sets provides useful generic set type, methods & functions.
+*/
+package sets
+
+// Add adds values into this set
+func (s Set[T]) Add(values ...T) Set[T] {
+	for _, value := range values {
+		s[value] = struct{}{}
+	}
+	return s
+}
+
+// Remove removes values from this set
+func (s Set[T]) Remove(values ...T) Set[T] {
+	for _, value := range values {
+		delete(s, value)
+	}
+	return s
+}
+
+// Contains returns true if value is present in this set
+func (s Set[T]) Contains(value T) bool {
+	_, found := s[value]
+	return found
+}
+
+// Contains returns true if value is present in specified set
+func Contains[T comparable](s Set[T], value T) bool {
+	return s.Contains(value)
+}
+
+// Len returns number of values in this set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Slice returns values of this set with no defined order
+func (s Set[T]) Slice() []T {
+	values := make([]T, 0, len(s))
+	for value := range s {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Slice returns values of specified set with no defined order
+func Slice[T comparable](s Set[T]) []T {
+	return s.Slice()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Copy returns copy of this set
+func (s Set[T]) Copy() Set[T] {
+	if s == nil {
+		return nil
+	}
+
+	copySet := make(Set[T], len(s))
+	for value := range s {
+		copySet[value] = struct{}{}
+	}
+	return copySet
+}
+
+// Copy returns copy of specified set
+func Copy[T comparable](s Set[T]) Set[T] {
+	return s.Copy()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Union returns new set with values present in this or provided set
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	union := make(Set[T], len(s)+len(other))
+	for value := range s {
+		union[value] = struct{}{}
+	}
+	return union.UnionSelf(other)
+}
+
+// UnionSelf adds values of provided set into this
+func (s Set[T]) UnionSelf(other Set[T]) Set[T] {
+	for value := range other {
+		s[value] = struct{}{}
+	}
+	return s
+}
+
+// Union returns new set with values present in any of specified sets
+func Union[T comparable](this, other Set[T]) Set[T] {
+	return this.Union(other)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Intersection returns new set with values present in both this and provided set
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	smaller, larger := s, other
+	if len(larger) < len(smaller) {
+		smaller, larger = larger, smaller
+	}
+
+	intersection := make(Set[T])
+	for value := range smaller {
+		if larger.Contains(value) {
+			intersection[value] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// IntersectionSelf removes values from this set that are not present in provided set
+func (s Set[T]) IntersectionSelf(other Set[T]) Set[T] {
+	for value := range s {
+		if !other.Contains(value) {
+			delete(s, value)
+		}
+	}
+	return s
+}
+
+// Intersection returns new set with values present in both specified sets
+func Intersection[T comparable](this, other Set[T]) Set[T] {
+	return this.Intersection(other)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Difference returns new set with values present in this set, but not in provided one
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	difference := make(Set[T])
+	for value := range s {
+		if !other.Contains(value) {
+			difference[value] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// DifferenceSelf removes values from this set that are present in provided set
+func (s Set[T]) DifferenceSelf(other Set[T]) Set[T] {
+	for value := range other {
+		delete(s, value)
+	}
+	return s
+}
+
+// Difference returns new set with values present in the first specified set, but not in the second one
+func Difference[T comparable](this, other Set[T]) Set[T] {
+	return this.Difference(other)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// SymmetricDifference returns new set with values present in exactly one of this and provided set
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	difference := s.Difference(other)
+	for value := range other {
+		if !s.Contains(value) {
+			difference[value] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// SymmetricDifferenceSelf keeps in this set only values present in exactly one of this and provided set
+func (s Set[T]) SymmetricDifferenceSelf(other Set[T]) Set[T] {
+	for value := range other {
+		if s.Contains(value) {
+			delete(s, value)
+		} else {
+			s[value] = struct{}{}
+		}
+	}
+	return s
+}
+
+// SymmetricDifference returns new set with values present in exactly one of specified sets
+func SymmetricDifference[T comparable](this, other Set[T]) Set[T] {
+	return this.SymmetricDifference(other)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// IsSubset returns true if all values of this set are present in provided set
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for value := range s {
+		if !other.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSubset returns true if all values of the first specified set are present in the second one
+func IsSubset[T comparable](this, other Set[T]) bool {
+	return this.IsSubset(other)
+}
+
+// IsSuperset returns true if all values of provided set are present in this set
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsSuperset returns true if all values of the second specified set are present in the first one
+func IsSuperset[T comparable](this, other Set[T]) bool {
+	return this.IsSuperset(other)
+}
+
+// Disjoint returns true if this and provided set have no values in common
+func (s Set[T]) Disjoint(other Set[T]) bool {
+	smaller, larger := s, other
+	if len(larger) < len(smaller) {
+		smaller, larger = larger, smaller
+	}
+
+	for value := range smaller {
+		if larger.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Disjoint returns true if specified sets have no values in common
+func Disjoint[T comparable](this, other Set[T]) bool {
+	return this.Disjoint(other)
+}
+
+// Equal returns true if this and provided set have the same values
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// Equal returns true if specified sets have the same values
+func Equal[T comparable](this, other Set[T]) bool {
+	return this.Equal(other)
+}
@@ -0,0 +1,182 @@
+package sets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructors(t *testing.T) {
+	assert.Equal(t, Set[int]{1: {}, 2: {}}, New(1, 2, 1))
+	assert.Equal(t, Set[int]{}, New[int]())
+	assert.Equal(t, Set[string]{"a": {}}, FromSlice([]string{"a", "a"}))
+	assert.Equal(t, Set[int]{1: {}}, ToSet(map[int]struct{}{1: {}}))
+
+	type namedMap map[string]int
+	assert.Equal(t, New("a", "b"), FromMapKeys(map[string]int{"a": 1, "b": 2}))
+	assert.Equal(t, New("a", "b"), FromMapKeys(namedMap{"a": 1, "b": 2}))
+}
+
+func TestS_AddRemoveContains(t *testing.T) {
+	s := New[int]()
+	s.Add(1, 2, 3).Remove(2, 4)
+
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Contains(1))
+	assert.False(t, s.Contains(2))
+	assert.True(t, Contains(s, 3))
+	assert.ElementsMatch(t, []int{1, 3}, s.Slice())
+	assert.ElementsMatch(t, []int{1, 3}, Slice(s))
+
+	var nilSet Set[int]
+	assert.False(t, nilSet.Contains(1))
+	assert.Equal(t, []int{}, nilSet.Slice())
+	assert.Nil(t, nilSet.Copy())
+}
+
+var setsTestCases = []struct {
+	name                string
+	this                Set[int]
+	other               Set[int]
+	union               Set[int]
+	intersection        Set[int]
+	difference          Set[int]
+	symmetricDifference Set[int]
+	isSubset            bool
+	isSuperset          bool
+	disjoint            bool
+	equal               bool
+}{
+	{
+		name:                "nil-nil",
+		this:                nil,
+		other:               nil,
+		union:               Set[int]{},
+		intersection:        Set[int]{},
+		difference:          Set[int]{},
+		symmetricDifference: Set[int]{},
+		isSubset:            true,
+		isSuperset:          true,
+		disjoint:            true,
+		equal:               true,
+	},
+	{
+		name:                "empty-one",
+		this:                New[int](),
+		other:               New(1),
+		union:               New(1),
+		intersection:        New[int](),
+		difference:          New[int](),
+		symmetricDifference: New(1),
+		isSubset:            true,
+		isSuperset:          false,
+		disjoint:            true,
+		equal:               false,
+	},
+	{
+		name:                "same",
+		this:                New(1, 2),
+		other:               New(2, 1),
+		union:               New(1, 2),
+		intersection:        New(1, 2),
+		difference:          New[int](),
+		symmetricDifference: New[int](),
+		isSubset:            true,
+		isSuperset:          true,
+		disjoint:            false,
+		equal:               true,
+	},
+	{
+		name:                "overlap",
+		this:                New(1, 2, 3),
+		other:               New(3, 4),
+		union:               New(1, 2, 3, 4),
+		intersection:        New(3),
+		difference:          New(1, 2),
+		symmetricDifference: New(1, 2, 4),
+		isSubset:            false,
+		isSuperset:          false,
+		disjoint:            false,
+		equal:               false,
+	},
+	{
+		name:                "superset",
+		this:                New(1, 2, 3),
+		other:               New(1, 2),
+		union:               New(1, 2, 3),
+		intersection:        New(1, 2),
+		difference:          New(3),
+		symmetricDifference: New(3),
+		isSubset:            false,
+		isSuperset:          true,
+		disjoint:            false,
+		equal:               false,
+	},
+	{
+		name:                "disjoint",
+		this:                New(1),
+		other:               New(2),
+		union:               New(1, 2),
+		intersection:        New[int](),
+		difference:          New(1),
+		symmetricDifference: New(1, 2),
+		isSubset:            false,
+		isSuperset:          false,
+		disjoint:            true,
+		equal:               false,
+	},
+}
+
+func TestS_Algebra(t *testing.T) {
+	for _, tt := range setsTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			this := tt.this.Copy()
+
+			assert.Equal(t, tt.union, tt.this.Union(tt.other))
+			assert.Equal(t, tt.union, Union(tt.this, tt.other))
+			assert.Equal(t, tt.intersection, tt.this.Intersection(tt.other))
+			assert.Equal(t, tt.intersection, Intersection(tt.this, tt.other))
+			assert.Equal(t, tt.difference, tt.this.Difference(tt.other))
+			assert.Equal(t, tt.difference, Difference(tt.this, tt.other))
+			assert.Equal(t, tt.symmetricDifference, tt.this.SymmetricDifference(tt.other))
+			assert.Equal(t, tt.symmetricDifference, SymmetricDifference(tt.this, tt.other))
+
+			assert.Equal(t, tt.isSubset, tt.this.IsSubset(tt.other))
+			assert.Equal(t, tt.isSubset, IsSubset(tt.this, tt.other))
+			assert.Equal(t, tt.isSuperset, tt.this.IsSuperset(tt.other))
+			assert.Equal(t, tt.isSuperset, IsSuperset(tt.this, tt.other))
+			assert.Equal(t, tt.disjoint, tt.this.Disjoint(tt.other))
+			assert.Equal(t, tt.disjoint, Disjoint(tt.this, tt.other))
+			assert.Equal(t, tt.equal, tt.this.Equal(tt.other))
+			assert.Equal(t, tt.equal, Equal(tt.this, tt.other))
+
+			assert.Equal(t, this, tt.this)
+		})
+	}
+}
+
+func TestS_AlgebraSelf(t *testing.T) {
+	for _, tt := range setsTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.this == nil {
+				return
+			}
+
+			this := Copy(tt.this)
+			assert.Equal(t, tt.union, this.UnionSelf(tt.other))
+			assert.Equal(t, tt.union, this)
+
+			this = tt.this.Copy()
+			assert.Equal(t, tt.intersection, this.IntersectionSelf(tt.other))
+			assert.Equal(t, tt.intersection, this)
+
+			this = tt.this.Copy()
+			assert.Equal(t, tt.difference, this.DifferenceSelf(tt.other))
+			assert.Equal(t, tt.difference, this)
+
+			this = tt.this.Copy()
+			assert.Equal(t, tt.symmetricDifference, this.SymmetricDifferenceSelf(tt.other))
+			assert.Equal(t, tt.symmetricDifference, this)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package sets
+
+// Set represents generic set of unique values with useful methods
+type Set[T comparable] map[T]struct{}
+
+func ToSet[T comparable](m map[T]struct{}) Set[T] {
+	return m
+}
+
+// New creates new set with specified values
+func New[T comparable](values ...T) Set[T] {
+	return FromSlice(values)
+}
+
+// FromSlice creates new set with values of specified slice
+func FromSlice[T comparable](values []T) Set[T] {
+	s := make(Set[T], len(values))
+	for _, value := range values {
+		s[value] = struct{}{}
+	}
+	return s
+}
+
+// FromMapKeys creates new set with keys of specified map
+func FromMapKeys[K comparable, V any](m map[K]V) Set[K] {
+	s := make(Set[K], len(m))
+	for key := range m {
+		s[key] = struct{}{}
+	}
+	return s
+}
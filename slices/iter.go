@@ -0,0 +1,59 @@
+//go:build go1.23
+
+package slices
+
+import "iter"
+
+// AllSeq returns iterator over index-value pairs of this slice in order
+func (s Slice[T]) AllSeq() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, value := range s {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+// AllSeq returns iterator over index-value pairs of specified slice in order
+func AllSeq[T any](s []T) iter.Seq2[int, T] {
+	return Slice[T](s).AllSeq()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// ValuesSeq returns iterator over values of this slice in order
+func (s Slice[T]) ValuesSeq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range s {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns iterator over values of specified slice in order
+func ValuesSeq[T any](s []T) iter.Seq[T] {
+	return Slice[T](s).ValuesSeq()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Collect appends values from iterator to this slice and returns extended slice
+func (s Slice[T]) Collect(seq iter.Seq[T]) Slice[T] {
+	for value := range seq {
+		s = append(s, value)
+	}
+	return s
+}
+
+// Collect appends values from iterator to specified slice and returns extended slice
+func Collect[T any](s []T, seq iter.Seq[T]) Slice[T] {
+	return Slice[T](s).Collect(seq)
+}
+
+// FromSeq creates new slice filled with values from iterator
+func FromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	return make(Slice[T], 0).Collect(seq)
+}
@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package slices
+
+import (
+	stdslices "slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestS_Seq(t *testing.T) {
+	s := Slice[int]{1, 2, 3}
+
+	var indexes []int
+	for i, value := range s.AllSeq() {
+		indexes = append(indexes, i)
+		if value == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1}, indexes)
+
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Collect(s.ValuesSeq()))
+	assert.Equal(t, []int{1, 2, 3}, stdslices.Collect(ValuesSeq(s)))
+	assert.Equal(t, 3, len(stdslices.Collect(func(yield func(int) bool) {
+		for i := range AllSeq(s) {
+			if !yield(i) {
+				return
+			}
+		}
+	})))
+
+	for range s.ValuesSeq() {
+		break
+	}
+
+	assert.Equal(t, Slice[int]{1, 2, 3}, FromSeq(stdslices.Values([]int{1, 2, 3})))
+	assert.Equal(t, Slice[int]{}, FromSeq(stdslices.Values([]int(nil))))
+	assert.Equal(t, Slice[int]{0, 1, 2}, Collect([]int{0}, stdslices.Values([]int{1, 2})))
+}
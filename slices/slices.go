@@ -0,0 +1,256 @@
+/*
+Package slices provides useful generic types, methods & functions for slices.
+*/
+package slices
+
+import (
+	"github.com/mymmrac/aki/maps"
+	"github.com/mymmrac/aki/types"
+)
+
+// Filter returns new slice from this, filtered using provided predicate
+func (s Slice[T]) Filter(predicate Predicate[T]) Slice[T] {
+	if s == nil {
+		return nil
+	}
+
+	filtered := make(Slice[T], 0)
+	for _, value := range s {
+		if predicate(value) {
+			filtered = append(filtered, value)
+		}
+	}
+	return filtered
+}
+
+// FilterSelf filters this slice in place using provided predicate, reusing its underlying array
+func (s Slice[T]) FilterSelf(predicate Predicate[T]) Slice[T] {
+	filtered := s[:0]
+	for _, value := range s {
+		if predicate(value) {
+			filtered = append(filtered, value)
+		}
+	}
+
+	var empty T
+	for i := len(filtered); i < len(s); i++ {
+		s[i] = empty
+	}
+	return filtered
+}
+
+// Filter returns new slice from specified, filtered using provided predicate
+func Filter[T any](s []T, predicate Predicate[T]) Slice[T] {
+	return Slice[T](s).Filter(predicate)
+}
+
+// FilterSelf filters specified slice in place using provided predicate, reusing its underlying array
+func FilterSelf[T any](s []T, predicate Predicate[T]) Slice[T] {
+	return Slice[T](s).FilterSelf(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Map returns new slice with values of specified slice converted using provided mapper
+func Map[T, R any](s []T, mapper func(value T) R) Slice[R] {
+	if s == nil {
+		return nil
+	}
+
+	mapped := make(Slice[R], len(s))
+	for i, value := range s {
+		mapped[i] = mapper(value)
+	}
+	return mapped
+}
+
+// CloneSlice returns new slice from specified with cloned values
+func CloneSlice[T types.Cloner[T]](s []T) Slice[T] {
+	if s == nil {
+		return nil
+	}
+
+	cloned := make(Slice[T], len(s))
+	for i, value := range s {
+		cloned[i] = value.Clone()
+	}
+	return cloned
+}
+
+// FlatMap returns new slice with concatenated results of provided mapper called on each value of specified slice
+func FlatMap[T, R any](s []T, mapper func(value T) []R) Slice[R] {
+	if s == nil {
+		return nil
+	}
+
+	mapped := make(Slice[R], 0, len(s))
+	for _, value := range s {
+		mapped = append(mapped, mapper(value)...)
+	}
+	return mapped
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Reduce reduces values of this slice into one starting from initial value using provided reducer
+func (s Slice[T]) Reduce(initial T, reducer func(accumulator, value T) T) T {
+	return Reduce(s, initial, reducer)
+}
+
+// Reduce reduces values of specified slice into one starting from initial value using provided reducer, type of
+// result can differ from type of values
+func Reduce[T, R any](s []T, initial R, reducer func(accumulator R, value T) R) R {
+	accumulator := initial
+	for _, value := range s {
+		accumulator = reducer(accumulator, value)
+	}
+	return accumulator
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Chunk splits this slice into consecutive chunks of specified size, the last chunk can be smaller, returns nil if
+// size is not positive, chunks share underlying array with this slice
+func (s Slice[T]) Chunk(size int) []Slice[T] {
+	if size <= 0 {
+		return nil
+	}
+
+	chunks := make([]Slice[T], 0, (len(s)+size-1)/size)
+	for start := 0; start < len(s); start += size {
+		end := start + size
+		if end > len(s) {
+			end = len(s)
+		}
+		chunks = append(chunks, s[start:end:end])
+	}
+	return chunks
+}
+
+// Chunk splits specified slice into consecutive chunks of specified size, the last chunk can be smaller, returns nil
+// if size is not positive, chunks share underlying array with specified slice
+func Chunk[T any](s []T, size int) []Slice[T] {
+	return Slice[T](s).Chunk(size)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Window returns all sliding windows of specified size of this slice, returns nil if size is not positive, windows
+// share underlying array with this slice
+func (s Slice[T]) Window(size int) []Slice[T] {
+	if size <= 0 {
+		return nil
+	}
+
+	if size > len(s) {
+		return []Slice[T]{}
+	}
+
+	windows := make([]Slice[T], 0, len(s)-size+1)
+	for start := 0; start+size <= len(s); start++ {
+		windows = append(windows, s[start:start+size:start+size])
+	}
+	return windows
+}
+
+// Window returns all sliding windows of specified size of specified slice, returns nil if size is not positive,
+// windows share underlying array with specified slice
+func Window[T any](s []T, size int) []Slice[T] {
+	return Slice[T](s).Window(size)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Partition splits this slice into values that match provided predicate and values that do not
+func (s Slice[T]) Partition(predicate Predicate[T]) (matched, unmatched Slice[T]) {
+	matched = make(Slice[T], 0)
+	unmatched = make(Slice[T], 0)
+	for _, value := range s {
+		if predicate(value) {
+			matched = append(matched, value)
+		} else {
+			unmatched = append(unmatched, value)
+		}
+	}
+	return matched, unmatched
+}
+
+// Partition splits specified slice into values that match provided predicate and values that do not
+func Partition[T any](s []T, predicate Predicate[T]) (matched, unmatched Slice[T]) {
+	return Slice[T](s).Partition(predicate)
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// GroupBy groups values of specified slice by keys returned from provided key selector, order of values in each
+// group is preserved
+func GroupBy[T any, K comparable](s []T, keySelector func(value T) K) maps.Map[K, []T] {
+	groups := make(maps.Map[K, []T])
+	for _, value := range s {
+		key := keySelector(value)
+		groups[key] = append(groups[key], value)
+	}
+	return groups
+}
+
+// ToMap returns map from values of specified slice by keys returned from provided key selector, later values
+// overwrite earlier ones with the same key
+func ToMap[T any, K comparable](s []T, keySelector func(value T) K) maps.Map[K, T] {
+	m := make(maps.Map[K, T], len(s))
+	for _, value := range s {
+		m[keySelector(value)] = value
+	}
+	return m
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Distinct returns new slice with unique values of specified slice, order of first occurrences is preserved
+func Distinct[T comparable](s []T) Slice[T] {
+	if s == nil {
+		return nil
+	}
+
+	seen := make(map[T]struct{}, len(s))
+	distinct := make(Slice[T], 0)
+	for _, value := range s {
+		if _, found := seen[value]; found {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		distinct = append(distinct, value)
+	}
+	return distinct
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Zip returns slice of pairs of values with the same index from specified slices, length of result is equal to
+// length of the shortest slice
+func Zip[A, B any](first []A, second []B) Slice[Pair[A, B]] {
+	length := len(first)
+	if len(second) < length {
+		length = len(second)
+	}
+
+	zipped := make(Slice[Pair[A, B]], length)
+	for i := 0; i < length; i++ {
+		zipped[i] = Pair[A, B]{
+			First:  first[i],
+			Second: second[i],
+		}
+	}
+	return zipped
+}
+
+// Unzip splits slice of pairs into slices of first and second values
+func Unzip[A, B any](pairs []Pair[A, B]) (Slice[A], Slice[B]) {
+	first := make(Slice[A], len(pairs))
+	second := make(Slice[B], len(pairs))
+	for i, pair := range pairs {
+		first[i] = pair.First
+		second[i] = pair.Second
+	}
+	return first, second
+}
@@ -0,0 +1,206 @@
+package slices
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mymmrac/aki/maps"
+)
+
+func TestToSlice(t *testing.T) {
+	s1 := []int{1, 2}
+	var s2 []int
+	var s3 Slice[int]
+
+	t.Run("slice", func(t *testing.T) {
+		assert.Equal(t, Slice[int](s1), ToSlice(s1))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, s3, ToSlice(s2))
+	})
+}
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+var sliceTestCases = []struct {
+	name      string
+	s         Slice[int]
+	filtered  Slice[int]
+	unmatched Slice[int]
+	mapped    Slice[string]
+	sum       int
+}{
+	{
+		name:      "nil",
+		s:         nil,
+		filtered:  nil,
+		unmatched: Slice[int]{},
+		mapped:    nil,
+		sum:       0,
+	},
+	{
+		name:      "empty",
+		s:         Slice[int]{},
+		filtered:  Slice[int]{},
+		unmatched: Slice[int]{},
+		mapped:    Slice[string]{},
+		sum:       0,
+	},
+	{
+		name:      "one_value",
+		s:         Slice[int]{1},
+		filtered:  Slice[int]{},
+		unmatched: Slice[int]{1},
+		mapped:    Slice[string]{"1"},
+		sum:       1,
+	},
+	{
+		name:      "multiple_values",
+		s:         Slice[int]{1, 2, 3, 4, 5},
+		filtered:  Slice[int]{2, 4},
+		unmatched: Slice[int]{1, 3, 5},
+		mapped:    Slice[string]{"1", "2", "3", "4", "5"},
+		sum:       15,
+	},
+}
+
+func TestS_Filter(t *testing.T) {
+	for _, tt := range sliceTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.filtered, tt.s.Filter(isEven))
+			assert.Equal(t, tt.filtered, Filter(tt.s, isEven))
+		})
+	}
+}
+
+func TestS_FilterSelf(t *testing.T) {
+	for _, tt := range sliceTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := append(Slice[int](nil), tt.s...)
+			filtered := s.FilterSelf(isEven)
+			assert.ElementsMatch(t, tt.filtered, filtered)
+
+			s = append(Slice[int](nil), tt.s...)
+			assert.ElementsMatch(t, tt.filtered, FilterSelf(s, isEven))
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	for _, tt := range sliceTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.mapped, Map(tt.s, strconv.Itoa))
+		})
+	}
+}
+
+func TestS_Reduce(t *testing.T) {
+	for _, tt := range sliceTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := func(accumulator, value int) int { return accumulator + value }
+			assert.Equal(t, tt.sum, tt.s.Reduce(0, sum))
+			assert.Equal(t, tt.sum, Reduce(tt.s, 0, sum))
+		})
+	}
+}
+
+func TestS_Partition(t *testing.T) {
+	for _, tt := range sliceTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := tt.filtered
+			if filtered == nil {
+				filtered = Slice[int]{}
+			}
+
+			matched, unmatched := tt.s.Partition(isEven)
+			assert.Equal(t, filtered, matched)
+			assert.Equal(t, tt.unmatched, unmatched)
+
+			matched, unmatched = Partition(tt.s, isEven)
+			assert.Equal(t, filtered, matched)
+			assert.Equal(t, tt.unmatched, unmatched)
+		})
+	}
+}
+
+type buffer struct {
+	data []int
+}
+
+func (b buffer) Clone() buffer {
+	return buffer{data: append([]int(nil), b.data...)}
+}
+
+func TestCloneSlice(t *testing.T) {
+	assert.Nil(t, CloneSlice[buffer](nil))
+	assert.Equal(t, Slice[buffer]{}, CloneSlice([]buffer{}))
+
+	s := []buffer{{data: []int{1}}, {data: []int{2, 3}}}
+	cloned := CloneSlice(s)
+	assert.Equal(t, Slice[buffer](s), cloned)
+
+	cloned[0].data[0] = -1
+	assert.Equal(t, []int{1}, s[0].data)
+}
+
+func TestFlatMap(t *testing.T) {
+	assert.Nil(t, FlatMap([]int(nil), func(value int) []int { return []int{value} }))
+	assert.Equal(t, Slice[int]{1, 2, 2, 3, 3, 3}, FlatMap([]int{1, 2, 3}, func(value int) []int {
+		result := make([]int, value)
+		for i := range result {
+			result[i] = value
+		}
+		return result
+	}))
+}
+
+func TestS_Chunk(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4, 5}
+
+	assert.Nil(t, s.Chunk(0))
+	assert.Equal(t, []Slice[int]{}, Slice[int]{}.Chunk(2))
+	assert.Equal(t, []Slice[int]{{1, 2}, {3, 4}, {5}}, s.Chunk(2))
+	assert.Equal(t, []Slice[int]{{1, 2, 3, 4, 5}}, Chunk(s, 10))
+
+	chunks := s.Chunk(2)
+	chunks[0] = append(chunks[0], 10)
+	assert.Equal(t, Slice[int]{1, 2, 3, 4, 5}, s)
+}
+
+func TestS_Window(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4}
+
+	assert.Nil(t, s.Window(-1))
+	assert.Equal(t, []Slice[int]{}, s.Window(5))
+	assert.Equal(t, []Slice[int]{{1, 2}, {2, 3}, {3, 4}}, s.Window(2))
+	assert.Equal(t, []Slice[int]{{1, 2, 3, 4}}, Window(s, 4))
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy([]string{"a", "bb", "c", "dd", "eee"}, func(value string) int { return len(value) })
+	assert.Equal(t, maps.Map[int, []string]{1: {"a", "c"}, 2: {"bb", "dd"}, 3: {"eee"}}, groups)
+}
+
+func TestToMap(t *testing.T) {
+	m := ToMap([]string{"a", "bb", "cc"}, func(value string) int { return len(value) })
+	assert.Equal(t, maps.Map[int, string]{1: "a", 2: "cc"}, m)
+}
+
+func TestDistinct(t *testing.T) {
+	assert.Nil(t, Distinct([]int(nil)))
+	assert.Equal(t, Slice[int]{3, 1, 2}, Distinct([]int{3, 1, 3, 2, 1}))
+}
+
+func TestZip(t *testing.T) {
+	zipped := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	assert.Equal(t, Slice[Pair[int, string]]{NewPair(1, "a"), NewPair(2, "b")}, zipped)
+
+	first, second := Unzip(zipped)
+	assert.Equal(t, Slice[int]{1, 2}, first)
+	assert.Equal(t, Slice[string]{"a", "b"}, second)
+}
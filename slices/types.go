@@ -0,0 +1,28 @@
+package slices
+
+// Slice represents generic slice with useful methods
+type Slice[T any] []T
+
+func ToSlice[T any](s []T) Slice[T] {
+	return s
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Pair represents generic pair of values
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{
+		First:  first,
+		Second: second,
+	}
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Predicate defines slice predicate
+type Predicate[T any] func(value T) bool
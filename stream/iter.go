@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package stream
+
+import "iter"
+
+// Seq returns iterator over values of this stream, values are pulled lazily while iterating
+func (s Stream[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value, ok := s.Next(); ok; value, ok = s.Next() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,23 @@
+//go:build go1.23
+
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream_Seq(t *testing.T) {
+	s := Of(1, 2, 3, 4)
+
+	var values []int
+	for value := range s.Seq() {
+		values = append(values, value)
+		if value == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, values)
+	assert.Equal(t, []int{3, 4}, []int(s.ToSlice()))
+}
@@ -0,0 +1,294 @@
+/*
+Package stream provides lazy generic streams over slices, maps & sets.
+*/
+package stream
+
+import (
+	"sort"
+
+	"github.com/mymmrac/aki/maps"
+	"github.com/mymmrac/aki/sets"
+	"github.com/mymmrac/aki/slices"
+)
+
+// Stream represents lazy sequence of values, values are pulled one by one only when terminal operation is called,
+// stream can be consumed only once, zero value is an empty stream
+type Stream[T any] struct {
+	pull func() (T, bool)
+}
+
+// Generate creates new stream which values are pulled from provided function until it returns false
+func Generate[T any](next func() (T, bool)) Stream[T] {
+	return Stream[T]{
+		pull: next,
+	}
+}
+
+// Of creates new stream of specified values
+func Of[T any](values ...T) Stream[T] {
+	return FromSlice(values)
+}
+
+// FromSlice creates new stream of values of specified slice in order
+func FromSlice[T any](values []T) Stream[T] {
+	index := 0
+	return Generate(func() (T, bool) {
+		if index >= len(values) {
+			var empty T
+			return empty, false
+		}
+
+		value := values[index]
+		index++
+		return value, true
+	})
+}
+
+// FromEntries creates new stream of entries of specified map with no defined order, entries are taken from map when
+// the first value is pulled
+func FromEntries[K comparable, V any](m maps.Map[K, V]) Stream[maps.Entry[K, V]] {
+	return deferred(m.Entries)
+}
+
+// FromSet creates new stream of values of specified set with no defined order, values are taken from set when the
+// first value is pulled
+func FromSet[T comparable](s sets.Set[T]) Stream[T] {
+	return deferred(s.Slice)
+}
+
+// deferred creates new stream of values returned by provided function called when the first value is pulled
+func deferred[T any](values func() []T) Stream[T] {
+	var source Stream[T]
+	return Generate(func() (T, bool) {
+		if source.pull == nil {
+			source = FromSlice(values())
+		}
+		return source.Next()
+	})
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Next pulls next value of this stream, returns false if stream has no more values
+func (s Stream[T]) Next() (T, bool) {
+	if s.pull == nil {
+		var empty T
+		return empty, false
+	}
+	return s.pull()
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// Filter returns stream of values of this stream that match provided predicate
+func (s Stream[T]) Filter(predicate slices.Predicate[T]) Stream[T] {
+	return Generate(func() (T, bool) {
+		for {
+			value, ok := s.Next()
+			if !ok || predicate(value) {
+				return value, ok
+			}
+		}
+	})
+}
+
+// Map returns stream of values of specified stream converted using provided mapper
+func Map[T, R any](s Stream[T], mapper func(value T) R) Stream[R] {
+	return Generate(func() (R, bool) {
+		value, ok := s.Next()
+		if !ok {
+			var empty R
+			return empty, false
+		}
+		return mapper(value), true
+	})
+}
+
+// FlatMap returns stream of concatenated results of provided mapper called on each value of specified stream
+func FlatMap[T, R any](s Stream[T], mapper func(value T) []R) Stream[R] {
+	var current []R
+	return Generate(func() (R, bool) {
+		for len(current) == 0 {
+			value, ok := s.Next()
+			if !ok {
+				var empty R
+				return empty, false
+			}
+			current = mapper(value)
+		}
+
+		value := current[0]
+		current = current[1:]
+		return value, true
+	})
+}
+
+// Take returns stream of at most n first values of this stream
+func (s Stream[T]) Take(n int) Stream[T] {
+	taken := 0
+	return Generate(func() (T, bool) {
+		if taken >= n {
+			var empty T
+			return empty, false
+		}
+
+		taken++
+		return s.Next()
+	})
+}
+
+// Skip returns stream of values of this stream without n first values
+func (s Stream[T]) Skip(n int) Stream[T] {
+	skipped := false
+	return Generate(func() (T, bool) {
+		if !skipped {
+			skipped = true
+			for i := 0; i < n; i++ {
+				if _, ok := s.Next(); !ok {
+					break
+				}
+			}
+		}
+		return s.Next()
+	})
+}
+
+// TakeWhile returns stream of first values of this stream while they match provided predicate
+func (s Stream[T]) TakeWhile(predicate slices.Predicate[T]) Stream[T] {
+	done := false
+	return Generate(func() (T, bool) {
+		if !done {
+			value, ok := s.Next()
+			if ok && predicate(value) {
+				return value, true
+			}
+			done = true
+		}
+
+		var empty T
+		return empty, false
+	})
+}
+
+// Distinct returns stream of unique values of specified stream, order of first occurrences is preserved
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	seen := make(map[T]struct{})
+	return s.Filter(func(value T) bool {
+		if _, found := seen[value]; found {
+			return false
+		}
+
+		seen[value] = struct{}{}
+		return true
+	})
+}
+
+// Sorted returns stream of values of this stream sorted using provided less function, sort is stable, all values
+// are pulled from this stream when the first value is pulled
+func (s Stream[T]) Sorted(less func(a, b T) bool) Stream[T] {
+	return deferred(func() []T {
+		values := s.ToSlice()
+		sort.SliceStable(values, func(i, j int) bool {
+			return less(values[i], values[j])
+		})
+		return values
+	})
+}
+
+// Peek returns stream of the same values as this stream calling provided action on each value when it is pulled
+func (s Stream[T]) Peek(action func(value T)) Stream[T] {
+	return Generate(func() (T, bool) {
+		value, ok := s.Next()
+		if ok {
+			action(value)
+		}
+		return value, ok
+	})
+}
+
+// ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ==== ====
+
+// ForEach calls provided action on each value of this stream
+func (s Stream[T]) ForEach(action func(value T)) {
+	for value, ok := s.Next(); ok; value, ok = s.Next() {
+		action(value)
+	}
+}
+
+// ToSlice collects values of this stream into slice
+func (s Stream[T]) ToSlice() slices.Slice[T] {
+	values := make(slices.Slice[T], 0)
+	s.ForEach(func(value T) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// ToMap collects entries of specified stream into map, later entries overwrite earlier ones with the same key
+func ToMap[K comparable, V any](s Stream[maps.Entry[K, V]]) maps.Map[K, V] {
+	m := make(maps.Map[K, V])
+	s.ForEach(func(entry maps.Entry[K, V]) {
+		m[entry.Key] = entry.Value
+	})
+	return m
+}
+
+// ToMapBy collects values of specified stream into map by keys returned from provided key selector, later values
+// overwrite earlier ones with the same key
+func ToMapBy[T any, K comparable](s Stream[T], keySelector func(value T) K) maps.Map[K, T] {
+	m := make(maps.Map[K, T])
+	s.ForEach(func(value T) {
+		m[keySelector(value)] = value
+	})
+	return m
+}
+
+// ToSet collects values of specified stream into set
+func ToSet[T comparable](s Stream[T]) sets.Set[T] {
+	set := make(sets.Set[T])
+	s.ForEach(func(value T) {
+		set[value] = struct{}{}
+	})
+	return set
+}
+
+// Reduce reduces values of specified stream into one starting from initial value using provided reducer
+func Reduce[T, R any](s Stream[T], initial R, reducer func(accumulator R, value T) R) R {
+	accumulator := initial
+	s.ForEach(func(value T) {
+		accumulator = reducer(accumulator, value)
+	})
+	return accumulator
+}
+
+// Count returns number of values of this stream
+func (s Stream[T]) Count() int {
+	count := 0
+	s.ForEach(func(_ T) {
+		count++
+	})
+	return count
+}
+
+// First returns the first value of this stream and true if stream is not empty
+func (s Stream[T]) First() (T, bool) {
+	return s.Next()
+}
+
+// AnyMatch returns true if any value of this stream matches provided predicate, stops on the first match
+func (s Stream[T]) AnyMatch(predicate slices.Predicate[T]) bool {
+	_, found := s.Filter(predicate).Next()
+	return found
+}
+
+// AllMatch returns true if all values of this stream match provided predicate, stops on the first mismatch
+func (s Stream[T]) AllMatch(predicate slices.Predicate[T]) bool {
+	return !s.AnyMatch(func(value T) bool {
+		return !predicate(value)
+	})
+}
+
+// NoneMatch returns true if no values of this stream match provided predicate, stops on the first match
+func (s Stream[T]) NoneMatch(predicate slices.Predicate[T]) bool {
+	return !s.AnyMatch(predicate)
+}
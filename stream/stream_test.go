@@ -0,0 +1,114 @@
+package stream
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mymmrac/aki/maps"
+	"github.com/mymmrac/aki/sets"
+	"github.com/mymmrac/aki/slices"
+)
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestSources(t *testing.T) {
+	assert.Equal(t, slices.Slice[int]{}, Stream[int]{}.ToSlice())
+	assert.Equal(t, slices.Slice[int]{}, Of[int]().ToSlice())
+	assert.Equal(t, slices.Slice[int]{1, 2, 3}, Of(1, 2, 3).ToSlice())
+	assert.Equal(t, slices.Slice[string]{"a"}, FromSlice([]string{"a"}).ToSlice())
+	assert.Equal(t, slices.Slice[int]{}, Generate[int](nil).ToSlice())
+
+	m := maps.Map[string, int]{"a": 1, "b": 2}
+	assert.ElementsMatch(t, m.Entries(), FromEntries(m).ToSlice())
+	assert.ElementsMatch(t, []int{1, 2}, FromSet(sets.New(1, 2)).ToSlice())
+
+	counter := 0
+	generated := Generate(func() (int, bool) {
+		counter++
+		return counter, true
+	})
+	assert.Equal(t, slices.Slice[int]{1, 2, 3}, generated.Take(3).ToSlice())
+}
+
+func TestStream_Laziness(t *testing.T) {
+	var pulled []int
+	s := Of(1, 2, 3, 4, 5, 6).
+		Peek(func(value int) { pulled = append(pulled, value) }).
+		Filter(isEven).
+		Take(2)
+	assert.Empty(t, pulled)
+
+	assert.Equal(t, slices.Slice[int]{2, 4}, s.ToSlice())
+	assert.Equal(t, []int{1, 2, 3, 4}, pulled)
+
+	deferredCalls := 0
+	source := deferred(func() []int {
+		deferredCalls++
+		return []int{1}
+	})
+	assert.Equal(t, 0, deferredCalls)
+	assert.Equal(t, 1, source.Count())
+	assert.Equal(t, 1, deferredCalls)
+}
+
+func TestStream_Intermediate(t *testing.T) {
+	assert.Equal(t, slices.Slice[string]{"2", "4"}, Map(Of(1, 2, 3, 4).Filter(isEven), strconv.Itoa).ToSlice())
+	assert.Equal(t, slices.Slice[int]{1, 1, 3, 3}, FlatMap(Of(1, 2, 3), func(value int) []int {
+		if isEven(value) {
+			return nil
+		}
+		return []int{value, value}
+	}).ToSlice())
+
+	assert.Equal(t, slices.Slice[int]{3, 4}, Of(1, 2, 3, 4).Skip(2).ToSlice())
+	assert.Equal(t, slices.Slice[int]{}, Of(1, 2).Skip(3).ToSlice())
+	assert.Equal(t, slices.Slice[int]{}, Of(1, 2).Take(0).ToSlice())
+	assert.Equal(t, slices.Slice[int]{1, 2}, Of(1, 2).Take(5).ToSlice())
+	assert.Equal(t, slices.Slice[int]{1, 3}, Of(1, 3, 4, 5).TakeWhile(func(value int) bool {
+		return !isEven(value)
+	}).ToSlice())
+	assert.Equal(t, slices.Slice[int]{3, 1, 2}, Distinct(Of(3, 1, 3, 2, 1)).ToSlice())
+
+	type pair struct {
+		key   int
+		value string
+	}
+	sorted := Of(pair{2, "a"}, pair{1, "b"}, pair{2, "c"}, pair{1, "d"}).Sorted(func(a, b pair) bool {
+		return a.key < b.key
+	})
+	assert.Equal(t, slices.Slice[pair]{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}, sorted.ToSlice())
+}
+
+func TestStream_Terminal(t *testing.T) {
+	entries := Map(Of("a", "bb", "cc"), func(value string) maps.Entry[int, string] {
+		return maps.NewEntry(len(value), value)
+	})
+	assert.Equal(t, maps.Map[int, string]{1: "a", 2: "cc"}, ToMap(entries))
+	assert.Equal(t, maps.Map[string, string]{"a": "a", "b": "bb"},
+		ToMapBy(Of("a", "bb"), func(value string) string { return value[:1] }))
+	assert.Equal(t, sets.New(1, 2), ToSet(Of(1, 2, 1)))
+
+	assert.Equal(t, 10, Reduce(Of(1, 2, 3, 4), 0, func(accumulator, value int) int { return accumulator + value }))
+	assert.Equal(t, 3, Of(1, 2, 3).Count())
+
+	first, ok := Of(5, 6).First()
+	assert.True(t, ok)
+	assert.Equal(t, 5, first)
+	_, ok = Of[int]().First()
+	assert.False(t, ok)
+
+	assert.True(t, Of(1, 2).AnyMatch(isEven))
+	assert.False(t, Of(1, 3).AnyMatch(isEven))
+	assert.True(t, Of(2, 4).AllMatch(isEven))
+	assert.False(t, Of(2, 3).AllMatch(isEven))
+	assert.True(t, Of(1, 3).NoneMatch(isEven))
+	assert.False(t, Of(1, 2).NoneMatch(isEven))
+
+	var visited []int
+	Of(1, 2).ForEach(func(value int) { visited = append(visited, value) })
+	assert.Equal(t, []int{1, 2}, visited)
+}
@@ -3,3 +3,11 @@ package types
 type Cloneable[T any] interface {
 	Clone() Cloneable[T]
 }
+
+// Cloner represents value that can clone itself into value of the same type
+//
+// Note: Deep cloning of arbitrary types isn't possible without reflection, so types opt in to deep cloning by
+// implementing Cloner (see maps.DeepCopy)
+type Cloner[T any] interface {
+	Clone() T
+}